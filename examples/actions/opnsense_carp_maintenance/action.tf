@@ -0,0 +1,22 @@
+// Hand over the CARP virtual IPs to the backup before a firmware upgrade:
+//   terraform apply -invoke=action.opnsense_carp_maintenance.enter
+action "opnsense_carp_maintenance" "enter" {
+  config {
+    maintenance_mode = true
+  }
+}
+
+// Take them back once the upgrade is done:
+//   terraform apply -invoke=action.opnsense_carp_maintenance.leave
+action "opnsense_carp_maintenance" "leave" {
+  config {
+    maintenance_mode = false
+  }
+}
+
+// Temporarily disable CARP, until it is enabled again or the firewall reboots
+action "opnsense_carp_maintenance" "disable" {
+  config {
+    enabled = false
+  }
+}
@@ -0,0 +1,25 @@
+// Kill all states created by a rule whenever its action changes, so that
+// switching from "pass" to "block" also terminates established connections.
+action "opnsense_firewall_kill_states" "ssh" {
+  config {
+    rule_id = opnsense_firewall_filter.ssh.id
+  }
+}
+
+resource "terraform_data" "ssh_rule_action" {
+  input = opnsense_firewall_filter.ssh.filter.action
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.opnsense_firewall_kill_states.ssh]
+    }
+  }
+}
+
+// Kill all states for a single host, e.g. with `terraform apply -invoke`
+action "opnsense_firewall_kill_states" "host" {
+  config {
+    filter = "192.168.1.10"
+  }
+}
@@ -0,0 +1,14 @@
+// Copy all legacy rules that can be migrated as is to automation rules.
+// Invoke once with `terraform apply -invoke=action.opnsense_firewall_migrate_legacy_filter.all`,
+// then import the reported rules into opnsense_firewall_filter resources.
+action "opnsense_firewall_migrate_legacy_filter" "all" {
+  config {}
+}
+
+// Migrate selected legacy rules, dropping the settings that can't be migrated
+action "opnsense_firewall_migrate_legacy_filter" "selected" {
+  config {
+    trackers           = ["1700000001", "1700000002"]
+    include_unmappable = true
+  }
+}
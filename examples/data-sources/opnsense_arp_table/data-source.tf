@@ -0,0 +1,21 @@
+resource "opnsense_interfaces_neighbor" "camera" {
+  ether_address = "00:11:22:aa:bb:cc"
+  ip_address    = "192.168.30.10"
+  description   = "IoT camera"
+}
+
+data "opnsense_arp_table" "iot" {
+  interface = "vlan030"
+}
+
+// Check that the static neighbor is present in the ARP table with the
+// expected hardware address
+check "static_neighbors" {
+  assert {
+    condition = contains(
+      [for e in data.opnsense_arp_table.iot.entries : e.mac_address if e.ip_address == opnsense_interfaces_neighbor.camera.ip_address],
+      opnsense_interfaces_neighbor.camera.ether_address
+    )
+    error_message = "The camera does not match the ARP table."
+  }
+}
@@ -0,0 +1,11 @@
+data "opnsense_carp_status" "primary" {}
+
+// Assert that the primary is master of all CARP virtual IPs
+check "primary_is_master" {
+  assert {
+    condition = alltrue([
+      for v in data.opnsense_carp_status.primary.vips : v.status == "MASTER"
+    ])
+    error_message = "The primary firewall is not master of all CARP virtual IPs."
+  }
+}
@@ -0,0 +1,15 @@
+// Export all aliases for an audit
+data "opnsense_firewall_alias_export" "all" {
+  format = "json"
+}
+
+resource "local_file" "aliases" {
+  filename = "${path.module}/aliases.json"
+  content  = data.opnsense_firewall_alias_export.all.content
+}
+
+// Export a subset of aliases as pfSense XML
+data "opnsense_firewall_alias_export" "allow_lists" {
+  format = "pfsense_xml"
+  names  = ["office_nets", "web_ports"]
+}
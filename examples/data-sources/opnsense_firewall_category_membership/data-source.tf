@@ -0,0 +1,11 @@
+data "opnsense_firewall_category_membership" "web_team" {
+  id = opnsense_firewall_category.web_team.id
+}
+
+// UUIDs of the filter rules owned by the web team
+output "web_team_filter_rules" {
+  value = [
+    for m in data.opnsense_firewall_category_membership.web_team.members :
+    m.id if m.type == "filter"
+  ]
+}
@@ -0,0 +1,16 @@
+// Analyze all rules on the LAN interface (and floating rules)
+data "opnsense_firewall_filter_analysis" "lan" {
+  interfaces = ["lan"]
+}
+
+// Fail the plan if any rule can never match
+check "no_shadowed_rules" {
+  assert {
+    condition = length([
+      for f in data.opnsense_firewall_filter_analysis.lan.findings : f if f.type == "shadowed"
+    ]) == 0
+    error_message = join("\n", [
+      for f in data.opnsense_firewall_filter_analysis.lan.findings : f.message if f.type == "shadowed"
+    ])
+  }
+}
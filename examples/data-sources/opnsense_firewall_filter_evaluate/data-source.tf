@@ -0,0 +1,53 @@
+// Would a TCP packet from 10.1.2.3 to 192.0.2.10:443 on LAN be passed by
+// the rules currently applied?
+data "opnsense_firewall_filter_evaluate" "https" {
+  interface           = "lan"
+  direction           = "in"
+  protocol            = "TCP"
+  source_address      = "10.1.2.3"
+  destination_address = "192.0.2.10"
+  destination_port    = 443
+}
+
+// Would it be passed by the planned rules? Rules and aliases managed by
+// Terraform are passed as input, so the plan fails before they are applied.
+resource "opnsense_firewall_alias" "web" {
+  name    = "web"
+  type    = "host"
+  content = ["192.0.2.10"]
+}
+
+resource "opnsense_firewall_filter" "https" {
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action    = "pass"
+    direction = "in"
+    protocol  = "TCP"
+    destination = {
+      net  = opnsense_firewall_alias.web.name
+      port = "443"
+    }
+  }
+}
+
+data "opnsense_firewall_filter_evaluate" "https_planned" {
+  interface           = "lan"
+  direction           = "in"
+  protocol            = "TCP"
+  source_address      = "10.1.2.3"
+  destination_address = "192.0.2.10"
+  destination_port    = 443
+
+  rules   = [opnsense_firewall_filter.https]
+  aliases = { (opnsense_firewall_alias.web.name) = opnsense_firewall_alias.web }
+
+  lifecycle {
+    postcondition {
+      condition     = self.action == "pass"
+      error_message = "HTTPS from LAN is not passed: ${jsonencode(self.trace)}"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "opnsense_firewall_filter_legacy" "all" {}
+
+// Legacy rules that can't be migrated as is, with the settings that are lost
+output "unmappable_legacy_rules" {
+  value = {
+    for rule in data.opnsense_firewall_filter_legacy.all.rules :
+    rule.tracker => rule.unmapped if length(rule.unmapped) > 0
+  }
+}
@@ -0,0 +1,21 @@
+// Get the packets blocked by a specific filter rule in the last 15 minutes
+data "opnsense_firewall_log" "blocked" {
+  rule_id = opnsense_firewall_filter.block_guests.id
+  action  = "block"
+  since   = "15m"
+}
+
+// Assert after apply that the rule is blocking traffic
+check "guests_blocked" {
+  assert {
+    condition     = length(data.opnsense_firewall_log.blocked.entries) > 0
+    error_message = "The guest block rule has not logged any blocked packets."
+  }
+}
+
+// Get the packets passed from a subnet to a host
+data "opnsense_firewall_log" "web" {
+  source      = "192.168.10.0/24"
+  destination = "192.168.1.10"
+  action      = "pass"
+}
@@ -0,0 +1,5 @@
+data "opnsense_firewall_nat_settings" "current" {}
+
+output "outbound_nat_mode" {
+  value = data.opnsense_firewall_nat_settings.current.mode
+}
@@ -0,0 +1,12 @@
+// Get statistics for all filter rules
+data "opnsense_firewall_rule_stats" "all" {}
+
+// Find rules that have never matched any packets
+output "unused_rules" {
+  value = [for r in data.opnsense_firewall_rule_stats.all.rules : r.id if r.packets == 0]
+}
+
+// Get statistics for a single rule
+data "opnsense_firewall_rule_stats" "ssh" {
+  ids = [opnsense_firewall_filter.ssh.id]
+}
@@ -0,0 +1,5 @@
+data "opnsense_firewall_settings" "current" {}
+
+output "default_state_policy" {
+  value = data.opnsense_firewall_settings.current.state_policy
+}
@@ -0,0 +1,14 @@
+// Get all states created by a specific filter rule
+data "opnsense_firewall_states" "ssh" {
+  rule_id = opnsense_firewall_filter.ssh.id
+}
+
+output "ssh_clients" {
+  value = distinct([for s in data.opnsense_firewall_states.ssh.states : s.source_address])
+}
+
+// Get all states for a host on the WAN interface
+data "opnsense_firewall_states" "host" {
+  interface = "vtnet0"
+  address   = "192.168.1.10"
+}
@@ -0,0 +1,24 @@
+// Counters and throughput of the WAN device, sampled over 5 seconds
+data "opnsense_interface_statistics" "wan" {
+  device          = "vtnet0"
+  sample_interval = 5
+}
+
+// Fail the run when the WAN is close to its 100 Mbit/s capacity
+check "wan_capacity" {
+  assert {
+    condition     = data.opnsense_interface_statistics.wan.interfaces[0].bits_in_per_second < 80000000
+    error_message = "The WAN is receiving more than 80 Mbit/s."
+  }
+}
+
+// Fail the run when the WAN reports errors after a change
+check "wan_errors" {
+  assert {
+    condition = (
+      data.opnsense_interface_statistics.wan.interfaces[0].errors_in == 0 &&
+      data.opnsense_interface_statistics.wan.interfaces[0].errors_out == 0
+    )
+    error_message = "The WAN reports interface errors."
+  }
+}
@@ -0,0 +1,9 @@
+data "opnsense_ndp_table" "lan" {
+  interface = "vtnet0"
+}
+
+output "lan_ipv6_neighbors" {
+  value = {
+    for e in data.opnsense_ndp_table.lan.entries : e.ip_address => e.mac_address
+  }
+}
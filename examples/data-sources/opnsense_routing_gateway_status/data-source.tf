@@ -0,0 +1,21 @@
+data "opnsense_routing_gateway_status" "all" {}
+
+// Fail the run when a gateway is down, e.g. after changing routes or rules
+check "gateways_online" {
+  assert {
+    condition = alltrue([
+      for g in data.opnsense_routing_gateway_status.all.gateways : g.status != "down"
+    ])
+    error_message = "At least one gateway is down."
+  }
+}
+
+// Warn about a degraded primary uplink
+check "wan_latency" {
+  assert {
+    condition = alltrue([
+      for g in data.opnsense_routing_gateway_status.all.gateways : g.rtt < 100 if g.name == "WAN_DHCP"
+    ])
+    error_message = "The round trip time of WAN_DHCP is above 100 ms."
+  }
+}
@@ -0,0 +1,21 @@
+resource "opnsense_route" "office" {
+  description = "Branch office"
+  gateway     = "WAN_DHCP"
+  network     = "10.20.0.0/16"
+}
+
+data "opnsense_routing_table" "ipv4" {
+  ip_protocol = "inet"
+
+  depends_on = [opnsense_route.office]
+}
+
+// Check that the managed route is installed in the kernel routing table
+check "office_route_installed" {
+  assert {
+    condition = anytrue([
+      for r in data.opnsense_routing_table.ipv4.routes : r.destination == opnsense_route.office.network
+    ])
+    error_message = "The route to the branch office is not installed."
+  }
+}
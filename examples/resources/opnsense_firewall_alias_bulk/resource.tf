@@ -0,0 +1,35 @@
+// Load allow-lists from a CSV file:
+//
+//   name,type,content,description
+//   office_nets,network,10.0.0.0/8 192.168.0.0/16,Office networks
+//   web_ports,port,80 443,Web ports
+resource "opnsense_firewall_alias_bulk" "allow_lists" {
+  format = "csv"
+  source = file("${path.module}/allow-lists.csv")
+}
+
+// Migrate the aliases of a pfSense configuration backup
+resource "opnsense_firewall_alias_bulk" "pfsense" {
+  format = "pfsense_xml"
+  source = file("${path.module}/config-pfsense.xml")
+}
+
+// Reference an alias by name in a filter rule
+resource "opnsense_firewall_filter" "office_web" {
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action   = "pass"
+    protocol = "TCP"
+    source = {
+      net = "office_nets"
+    }
+    destination = {
+      port = "web_ports"
+    }
+  }
+
+  depends_on = [opnsense_firewall_alias_bulk.allow_lists]
+}
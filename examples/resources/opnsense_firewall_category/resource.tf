@@ -1,6 +1,9 @@
 resource "opnsense_firewall_category" "example_one" {
   name  = "example"
   color = "ffaa00"
+
+  // Refuse to delete the category while aliases or rules still use it
+  prevent_destroy_if_in_use = true
 }
 
 resource "opnsense_firewall_alias" "example_one" {
@@ -21,3 +21,22 @@ resource "opnsense_firewall_nat_port_forward" "wan_https_k3s_ingress" {
 
   description = "WAN HTTPS to k3s Traefik ingress VIP"
 }
+
+// Forward SSH and let the provider manage the matching pass rule
+resource "opnsense_firewall_nat_port_forward" "wan_ssh_bastion" {
+  interface = ["wan"]
+  protocol  = "tcp"
+
+  destination = {
+    net  = "wanip"
+    port = "2222"
+  }
+
+  target = {
+    ip   = "10.1.1.30"
+    port = "22"
+  }
+
+  filter_rule_association = "managed"
+  description             = "WAN SSH to bastion"
+}
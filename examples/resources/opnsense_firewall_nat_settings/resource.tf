@@ -0,0 +1,7 @@
+// Import the singleton resource before managing it:
+// terraform import opnsense_firewall_nat_settings.settings firewall_nat_settings
+
+resource "opnsense_firewall_nat_settings" "settings" {
+  mode                    = "hybrid"
+  reflection_port_forward = true
+}
@@ -0,0 +1,19 @@
+// Translate an internal ULA prefix to a static provider prefix
+resource "opnsense_firewall_npt" "example_one" {
+  interface       = "wan"
+  internal_prefix = "fd00:10::/64"
+  external_prefix = "2001:db8:10::/64"
+  description     = "Example one"
+}
+
+// Derive the external prefix from a tracked (delegated) interface
+resource "opnsense_firewall_npt" "example_two" {
+  enabled         = true
+  log             = true
+  sequence        = 10
+  interface       = "opt1"
+  internal_prefix = "fd00:20::/64"
+  track_interface = "opt1"
+  categories      = ["8cb36e8e-1d72-480a-8268-bbdaf1ec6ed6"]
+  description     = "Example two"
+}
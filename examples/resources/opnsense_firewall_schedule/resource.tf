@@ -0,0 +1,34 @@
+// Office hours on weekdays, plus a public holiday
+resource "opnsense_firewall_schedule" "office_hours" {
+  name        = "office_hours"
+  description = "Office hours"
+
+  time_ranges = [
+    {
+      weekdays   = ["mon", "tue", "wed", "thu", "fri"]
+      start_time = "08:00"
+      end_time   = "17:59"
+    },
+    {
+      months      = [12]
+      days        = [25, 26]
+      start_time  = "00:00"
+      end_time    = "23:59"
+      description = "Christmas"
+    },
+  ]
+}
+
+// Only allow traffic from the guest network during office hours
+resource "opnsense_firewall_filter" "guest_office_hours" {
+  interface = {
+    interface = ["opt1"]
+  }
+
+  filter = {
+    action   = "pass"
+    schedule = opnsense_firewall_schedule.office_hours.name
+  }
+
+  description = "Allow guest network during office hours"
+}
@@ -0,0 +1,15 @@
+// Import the singleton resource before managing it:
+// terraform import opnsense_firewall_settings.settings firewall_settings
+
+resource "opnsense_firewall_settings" "settings" {
+  optimization     = "conservative"
+  state_policy     = "if-bound"
+  state_table_size = 500000
+
+  adaptive_timeouts = {
+    start = 300000
+    end   = 600000
+  }
+
+  bogons_update_interval = "weekly"
+}
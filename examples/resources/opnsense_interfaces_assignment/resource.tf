@@ -0,0 +1,43 @@
+resource "opnsense_interfaces_vlan" "guest" {
+  description = "Guest VLAN"
+  tag         = 20
+  parent      = "vtnet0"
+  device      = "vlan020"
+}
+
+// Assign the VLAN to a new interface with a static address
+resource "opnsense_interfaces_assignment" "guest" {
+  device           = opnsense_interfaces_vlan.guest.device
+  description      = "GUEST"
+  ipv4_type        = "static"
+  ipv4_address     = "192.168.20.1/24"
+  ipv6_type        = "track6"
+  track6_interface = "wan"
+  track6_prefix_id = 2
+}
+
+// Allow traffic from the new interface, referenced by its identifier
+resource "opnsense_firewall_filter" "guest_out" {
+  interface = {
+    interface = [opnsense_interfaces_assignment.guest.identifier]
+  }
+
+  filter = {
+    action = "pass"
+    source = {
+      net = opnsense_interfaces_assignment.guest.identifier
+    }
+  }
+
+  description = "Allow guest network"
+}
+
+// Configure an upstream interface using DHCP
+resource "opnsense_interfaces_assignment" "uplink" {
+  device        = "vtnet2"
+  description   = "UPLINK"
+  ipv4_type     = "dhcp"
+  ipv6_type     = "dhcp6"
+  block_private = true
+  block_bogons  = true
+}
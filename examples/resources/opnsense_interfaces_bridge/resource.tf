@@ -0,0 +1,5 @@
+resource "opnsense_interfaces_bridge" "lan" {
+  description = "LAN bridge"
+  members     = ["lan", "opt1"]
+  stp         = true
+}
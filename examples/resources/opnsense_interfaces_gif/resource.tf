@@ -0,0 +1,9 @@
+// IPv6 tunnel broker
+resource "opnsense_interfaces_gif" "tunnel_broker" {
+  description           = "IPv6 tunnel broker"
+  local_address         = "wan"
+  remote_address        = "198.51.100.1"
+  tunnel_local_address  = "2001:db8::2"
+  tunnel_remote_address = "2001:db8::1"
+  tunnel_remote_net     = 64
+}
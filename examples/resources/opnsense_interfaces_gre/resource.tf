@@ -0,0 +1,8 @@
+resource "opnsense_interfaces_gre" "site_b" {
+  description           = "Tunnel to site B"
+  local_address         = "wan"
+  remote_address        = "198.51.100.2"
+  tunnel_local_address  = "10.255.0.1"
+  tunnel_remote_address = "10.255.0.2"
+  tunnel_remote_net     = 30
+}
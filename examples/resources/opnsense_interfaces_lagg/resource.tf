@@ -0,0 +1,14 @@
+// Aggregate two ports using LACP
+resource "opnsense_interfaces_lagg" "uplink" {
+  description = "Uplink LAGG"
+  members     = ["vtnet1", "vtnet2"]
+  protocol    = "lacp"
+  hash        = ["l3", "l4"]
+}
+
+// Assign the LAGG to an interface using the generated device name
+resource "opnsense_interfaces_assignment" "uplink" {
+  device      = opnsense_interfaces_lagg.uplink.device
+  description = "UPLINK"
+  ipv4_type   = "dhcp"
+}
@@ -0,0 +1,10 @@
+resource "opnsense_interfaces_loopback" "router_id" {
+  description = "Router ID"
+}
+
+resource "opnsense_interfaces_assignment" "router_id" {
+  device       = opnsense_interfaces_loopback.router_id.device
+  description  = "ROUTERID"
+  ipv4_type    = "static"
+  ipv4_address = "10.0.0.1/32"
+}
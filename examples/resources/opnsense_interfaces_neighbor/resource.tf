@@ -0,0 +1,5 @@
+resource "opnsense_interfaces_neighbor" "camera" {
+  ether_address = "00:11:22:aa:bb:cc"
+  ip_address    = "192.168.30.10"
+  description   = "IoT camera"
+}
@@ -10,4 +10,20 @@ resource "opnsense_interfaces_vip" "ipalias_example_vip" {
     interface   = "wan"
     network     = "192.168.0.166/32"
     description = "ipalias example vip"
-}
\ No newline at end of file
+}
+
+// CARP VIP on the primary member of an HA pair; the backup uses the same
+// vhid and password with a higher advskew
+resource "opnsense_interfaces_vip" "carp_example_vip" {
+  mode        = "carp"
+  interface   = "lan"
+  network     = "192.168.1.1/24"
+  description = "carp example vip"
+
+  vhid    = 1
+  advbase = 1
+  advskew = 0
+
+  password         = var.carp_password
+  password_version = 1
+}
@@ -14,3 +14,18 @@ resource "opnsense_interfaces_vlan" "vlan04" {
   parent = "vtnet0"
   device = "vlan04"
 }
+
+// QinQ: attach a customer VLAN to an 802.1ad service VLAN
+resource "opnsense_interfaces_vlan" "service" {
+  description = "Service VLAN"
+  tag = 300
+  parent = "vtnet1"
+  proto = "802.1ad"
+  device = "qinq0300"
+}
+
+resource "opnsense_interfaces_vlan" "customer" {
+  description = "Customer VLAN"
+  tag = 10
+  parent = opnsense_interfaces_vlan.service.device
+}
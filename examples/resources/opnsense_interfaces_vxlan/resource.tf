@@ -0,0 +1,16 @@
+// Point-to-point VXLAN
+resource "opnsense_interfaces_vxlan" "p2p" {
+  description    = "Point-to-point VXLAN"
+  vni            = 100
+  local_address  = "192.168.1.1"
+  remote_address = "192.168.1.2"
+}
+
+// Multicast VXLAN
+resource "opnsense_interfaces_vxlan" "multicast" {
+  description      = "Multicast VXLAN"
+  vni              = 200
+  local_address    = "192.168.1.1"
+  group            = "239.0.0.200"
+  multicast_device = "vtnet1"
+}
@@ -0,0 +1,21 @@
+// Static gateway on a second WAN, monitored through a public resolver
+resource "opnsense_routing_gateway" "wan2" {
+  name        = "WAN2_GW"
+  description = "Backup uplink"
+
+  interface = "opt1"
+  address   = "203.0.113.1"
+
+  monitor_ip   = "9.9.9.9"
+  latency_low  = 100
+  latency_high = 300
+}
+
+// Dynamic IPv6 gateway, never marked down
+resource "opnsense_routing_gateway" "wan2_v6" {
+  name        = "WAN2_GW6"
+  interface   = "opt1"
+  ip_protocol = "inet6"
+
+  monitor_disabled = true
+}
@@ -0,0 +1,42 @@
+resource "opnsense_routing_gateway" "wan2" {
+  name      = "WAN2_GW"
+  interface = "opt1"
+  address   = "203.0.113.1"
+}
+
+// Fail over from the DHCP uplink to WAN2 on packet loss or high latency
+resource "opnsense_routing_gateway_group" "failover" {
+  name        = "WAN_FAILOVER"
+  description = "Primary with backup"
+  trigger     = "packet_loss_or_high_latency"
+
+  members = [
+    {
+      gateway = "WAN_DHCP"
+      tier    = 1
+    },
+    {
+      gateway = opnsense_routing_gateway.wan2.name
+      tier    = 2
+    },
+  ]
+}
+
+// Policy route LAN traffic through the group
+resource "opnsense_firewall_filter" "lan_failover" {
+  description = "LAN via WAN_FAILOVER"
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action    = "pass"
+    direction = "in"
+    protocol  = "any"
+  }
+
+  source_routing = {
+    gateway = opnsense_routing_gateway_group.failover.name
+  }
+}
@@ -0,0 +1,16 @@
+// Limit the total download bandwidth to 100 Mbit/s, shared fairly
+// between all hosts with FQ-CoDel
+resource "opnsense_trafficshaper_pipe" "download" {
+  bandwidth        = 100
+  bandwidth_metric = "Mbit"
+  scheduler        = "fq_codel"
+  codel_ecn        = true
+  description      = "Download"
+}
+
+// Give every LAN host its own 10 Mbit/s pipe
+resource "opnsense_trafficshaper_pipe" "per_host" {
+  bandwidth   = 10
+  mask        = "dst-ip"
+  description = "Per host download"
+}
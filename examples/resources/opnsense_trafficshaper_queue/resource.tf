@@ -0,0 +1,17 @@
+resource "opnsense_trafficshaper_pipe" "download" {
+  bandwidth   = 100
+  description = "Download"
+}
+
+// High priority traffic receives 90% of the pipe when it is saturated
+resource "opnsense_trafficshaper_queue" "high" {
+  pipe        = opnsense_trafficshaper_pipe.download.id
+  weight      = 90
+  description = "Download high priority"
+}
+
+resource "opnsense_trafficshaper_queue" "low" {
+  pipe        = opnsense_trafficshaper_pipe.download.id
+  weight      = 10
+  description = "Download low priority"
+}
@@ -0,0 +1,20 @@
+resource "opnsense_trafficshaper_pipe" "download" {
+  bandwidth   = 100
+  description = "Download"
+}
+
+resource "opnsense_trafficshaper_queue" "low" {
+  pipe        = opnsense_trafficshaper_pipe.download.id
+  weight      = 10
+  description = "Download low priority"
+}
+
+// Send traffic to the backup server through the low priority queue
+resource "opnsense_trafficshaper_rule" "backup" {
+  interface   = "wan"
+  protocol    = "tcp"
+  destination = "192.168.1.20"
+  direction   = "in"
+  target      = opnsense_trafficshaper_queue.low.id
+  description = "Backup server download"
+}
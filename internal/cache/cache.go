@@ -0,0 +1,116 @@
+// Package cache shares lists read from OPNsense between the resources of a
+// plan, so they are fetched about once per plan instead of once per resource.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// TTL is how long a list is reused. A plan or refresh reads all resources
+// well within this time.
+const TTL = 30 * time.Second
+
+var (
+	clientsMu sync.Mutex
+	clients   = map[any]*Cache{}
+)
+
+// ForClient returns the cache of a provider instance, identified by its API
+// client. Caches of which every list has expired are dropped, so caches of
+// provider instances that are no longer used do not accumulate.
+func ForClient(client any) *Cache {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	for c, cache := range clients {
+		if c != client && cache.expired() {
+			delete(clients, c)
+		}
+	}
+
+	cache, ok := clients[client]
+	if !ok {
+		cache = New()
+		clients[client] = cache
+	}
+	return cache
+}
+
+// Cache holds lists by key for TTL.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+	now     func() time.Time
+}
+
+type entry struct {
+	// fetchMu makes concurrent callers of the same key wait for a single
+	// fetch. value and fetched are guarded by Cache.mu.
+	fetchMu sync.Mutex
+	value   any
+	fetched time.Time
+}
+
+func New() *Cache {
+	return &Cache{
+		entries: map[string]*entry{},
+		now:     time.Now,
+	}
+}
+
+// Get returns the value of key. It is fetched if it is not cached, has
+// expired, or refresh is set. Errors are not cached.
+func Get[V any](c *Cache, key string, refresh bool, fetch func() (V, error)) (V, error) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &entry{}
+		c.entries[key] = e
+	}
+	c.mu.Unlock()
+
+	e.fetchMu.Lock()
+	defer e.fetchMu.Unlock()
+
+	if !refresh {
+		if value, ok := c.lookup(e); ok {
+			return value.(V), nil
+		}
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	c.mu.Lock()
+	e.value, e.fetched = value, c.now()
+	c.mu.Unlock()
+	return value, nil
+}
+
+// lookup returns the value of e, unless it has not been fetched or has
+// expired.
+func (c *Cache) lookup(e *entry) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e.value == nil || c.now().Sub(e.fetched) >= TTL {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// expired returns whether every value has expired.
+func (c *Cache) expired() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range c.entries {
+		if c.now().Sub(e.fetched) < TTL {
+			return false
+		}
+	}
+	return true
+}
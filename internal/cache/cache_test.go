@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := New()
+	cache.now = func() time.Time { return now }
+
+	fetches := 0
+	fetch := func() ([]string, error) {
+		fetches++
+		return []string{"alias"}, nil
+	}
+
+	// The first lookup fetches, later lookups within the TTL do not
+	for range 3 {
+		values, err := Get(cache, "aliases", false, fetch)
+		require.NoError(t, err)
+		require.Equal(t, []string{"alias"}, values)
+	}
+	require.Equal(t, 1, fetches)
+
+	// Refreshing fetches again
+	_, err := Get(cache, "aliases", true, fetch)
+	require.NoError(t, err)
+	require.Equal(t, 2, fetches)
+
+	// Expired values are fetched again
+	now = now.Add(TTL)
+	_, err = Get(cache, "aliases", false, fetch)
+	require.NoError(t, err)
+	require.Equal(t, 3, fetches)
+
+	// Values are cached per key, whatever their type
+	_, err = Get(cache, "gateways", false, fetch)
+	require.NoError(t, err)
+	require.Equal(t, 4, fetches)
+
+	devices, err := Get(cache, "devices", false, func() (map[string]string, error) {
+		return map[string]string{"vtnet0": "lan"}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "lan", devices["vtnet0"])
+}
+
+func TestGet_Error(t *testing.T) {
+	cache := New()
+
+	_, err := Get(cache, "aliases", false, func() ([]string, error) {
+		return nil, errors.New("unavailable")
+	})
+	require.Error(t, err)
+
+	// Errors are not cached
+	values, err := Get(cache, "aliases", false, func() ([]string, error) {
+		return []string{"alias"}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alias"}, values)
+}
+
+func TestForClient(t *testing.T) {
+	first, second := new(int), new(int)
+
+	cache := ForClient(first)
+	require.Same(t, cache, ForClient(first))
+	require.NotSame(t, cache, ForClient(second))
+
+	// Caches of which every value has expired are dropped
+	_, err := Get(ForClient(second), "aliases", false, func() ([]string, error) {
+		return []string{"alias"}, nil
+	})
+	require.NoError(t, err)
+	ForClient(nil)
+
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+	_, ok := clients[first]
+	require.False(t, ok)
+	_, ok = clients[second]
+	require.True(t, ok)
+}
@@ -15,10 +15,12 @@ import (
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/openvpn"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/quagga"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/routes"
+	"github.com/browningluke/terraform-provider-opnsense/internal/service/trafficshaper"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/trust"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/unbound"
 	"github.com/browningluke/terraform-provider-opnsense/internal/service/wireguard"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,6 +34,7 @@ import (
 // Ensure OPNsenseProvider satisfies various provider interfaces.
 var _ provider.Provider = &opnsenseProvider{}
 var _ provider.ProviderWithEphemeralResources = &opnsenseProvider{}
+var _ provider.ProviderWithActions = &opnsenseProvider{}
 
 // OPNsenseProvider defines the provider implementation.
 type opnsenseProvider struct {
@@ -285,6 +288,7 @@ func (p *opnsenseProvider) Configure(ctx context.Context, req provider.Configure
 	resp.DataSourceData = client
 	resp.ResourceData = client
 	resp.EphemeralResourceData = client
+	resp.ActionData = client
 }
 
 func (p *opnsenseProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -298,6 +302,7 @@ func (p *opnsenseProvider) Resources(ctx context.Context) []func() resource.Reso
 		openvpn.Resources(ctx),
 		quagga.Resources(ctx),
 		routes.Resources(ctx),
+		trafficshaper.Resources(ctx),
 		trust.Resources(ctx),
 		unbound.Resources(ctx),
 		wireguard.Resources(ctx),
@@ -321,6 +326,7 @@ func (p *opnsenseProvider) DataSources(ctx context.Context) []func() datasource.
 		openvpn.DataSources(ctx),
 		quagga.DataSources(ctx),
 		routes.DataSources(ctx),
+		trafficshaper.DataSources(ctx),
 		trust.DataSources(ctx),
 		unbound.DataSources(ctx),
 		wireguard.DataSources(ctx),
@@ -345,6 +351,19 @@ func (p *opnsenseProvider) EphemeralResources(ctx context.Context) []func() ephe
 	return ephemerals
 }
 
+func (p *opnsenseProvider) Actions(ctx context.Context) []func() action.Action {
+	controllers := [][]func() action.Action{
+		firewall.Actions(ctx),
+		interfaces.Actions(ctx),
+	}
+
+	var actions []func() action.Action
+	for _, s := range controllers {
+		actions = append(actions, s...)
+	}
+	return actions
+}
+
 func NewProvider(ctx context.Context) (provider.Provider, error) {
 	return &opnsenseProvider{}, nil
 }
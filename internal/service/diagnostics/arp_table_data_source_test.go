@@ -0,0 +1,82 @@
+package diagnostics_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccArpTableDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNeighborTableDataSourceConfig("opnsense_arp_table"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The addresses of the firewall itself are always present
+					resource.TestCheckResourceAttrWith("data.opnsense_arp_table.all", "entries.#", testAccCheckNotEmpty),
+					resource.TestCheckResourceAttrSet("data.opnsense_arp_table.all", "entries.0.ip_address"),
+					resource.TestCheckResourceAttrSet("data.opnsense_arp_table.all", "entries.0.mac_address"),
+					// Filtered by the interface of the first entry
+					resource.TestCheckResourceAttrWith("data.opnsense_arp_table.filtered", "entries.#", testAccCheckNotEmpty),
+					testAccCheckEntriesOnInterface("data.opnsense_arp_table.filtered"),
+					resource.TestCheckResourceAttr("data.opnsense_arp_table.none", "entries.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccNeighborTableDataSourceConfig reads the neighbor table of dataSource
+// unfiltered, filtered by the interface of its first entry, and filtered by
+// an interface that does not exist.
+func testAccNeighborTableDataSourceConfig(dataSource string) string {
+	return fmt.Sprintf(`
+data %[1]q "all" {}
+
+data %[1]q "filtered" {
+  interface = data.%[1]s.all.entries[0].interface
+}
+
+data %[1]q "none" {
+  interface = "doesnotexist0"
+}
+`, dataSource)
+}
+
+func testAccCheckNotEmpty(value string) error {
+	if value == "0" {
+		return errors.New("expected at least one entry")
+	}
+	return nil
+}
+
+// testAccCheckEntriesOnInterface checks that all entries of a neighbor table
+// data source are on the interface it is filtered by.
+func testAccCheckEntriesOnInterface(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		attrs := rs.Primary.Attributes
+		count, err := strconv.Atoi(attrs["entries.#"])
+		if err != nil {
+			return fmt.Errorf("invalid entries count: %w", err)
+		}
+
+		for i := range count {
+			if iface := attrs[fmt.Sprintf("entries.%d.interface", i)]; iface != attrs["interface"] {
+				return fmt.Errorf("entry %d is on interface %q, expected %q", i, iface, attrs["interface"])
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,131 @@
+package diagnostics
+
+import (
+	"context"
+
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type arpTableDataSourceModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Entries   types.List   `tfsdk:"entries"`
+}
+
+type arpTableEntryModel struct {
+	IpAddress            types.String `tfsdk:"ip_address"`
+	MacAddress           types.String `tfsdk:"mac_address"`
+	Interface            types.String `tfsdk:"interface"`
+	InterfaceDescription types.String `tfsdk:"interface_description"`
+	Hostname             types.String `tfsdk:"hostname"`
+	Manufacturer         types.String `tfsdk:"manufacturer"`
+	Permanent            types.Bool   `tfsdk:"permanent"`
+	Expires              types.Int64  `tfsdk:"expires"`
+}
+
+var arpTableEntryAttrTypes = map[string]attr.Type{
+	"ip_address":            types.StringType,
+	"mac_address":           types.StringType,
+	"interface":             types.StringType,
+	"interface_description": types.StringType,
+	"hostname":              types.StringType,
+	"manufacturer":          types.StringType,
+	"permanent":             types.BoolType,
+	"expires":               types.Int64Type,
+}
+
+func arpTableDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "ArpTable returns the current IPv4 neighbor (ARP) cache of OPNsense, including static entries created by `opnsense_interfaces_neighbor`.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Only return entries on this interface device (e.g. `vtnet0`).",
+				Optional:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of ARP cache entries.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address": schema.StringAttribute{
+							MarkdownDescription: "IPv4 address of the neighbor.",
+							Computed:            true,
+						},
+						"mac_address": schema.StringAttribute{
+							MarkdownDescription: "Hardware (MAC) address of the neighbor.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Interface device the neighbor was seen on.",
+							Computed:            true,
+						},
+						"interface_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the interface the neighbor was seen on.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname of the neighbor, if it can be resolved.",
+							Computed:            true,
+						},
+						"manufacturer": schema.StringAttribute{
+							MarkdownDescription: "Manufacturer of the network card, derived from the MAC address.",
+							Computed:            true,
+						},
+						"permanent": schema.BoolAttribute{
+							MarkdownDescription: "Whether the entry is permanent, e.g. a static neighbor or an address of OPNsense itself.",
+							Computed:            true,
+						},
+						"expires": schema.Int64Attribute{
+							MarkdownDescription: "Seconds until the entry expires, `-1` for permanent entries.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertArpTableToSchema(d []diagnostics.ArpEntry, iface string) types.List {
+	var entries []arpTableEntryModel
+	for _, e := range d {
+		if iface != "" && e.Interface != iface {
+			continue
+		}
+
+		expires := e.Expires
+		if e.Permanent {
+			expires = -1
+		}
+
+		entries = append(entries, arpTableEntryModel{
+			IpAddress:            types.StringValue(e.IpAddress),
+			MacAddress:           types.StringValue(e.MacAddress),
+			Interface:            types.StringValue(e.Interface),
+			InterfaceDescription: types.StringValue(e.InterfaceDescription),
+			Hostname:             types.StringValue(e.Hostname),
+			Manufacturer:         types.StringValue(e.Manufacturer),
+			Permanent:            types.BoolValue(e.Permanent),
+			Expires:              types.Int64Value(expires),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: arpTableEntryAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(entries) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: arpTableEntryAttrTypes},
+			entries,
+		)
+	}
+
+	return v
+}
@@ -15,5 +15,9 @@ func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newInterfaceDataSource,
 		newInterfaceAllDataSource,
+		newArpTableDataSource,
+		newNdpTableDataSource,
+		newRoutingTableDataSource,
+		newInterfaceStatisticsDataSource,
 	}
 }
@@ -0,0 +1,117 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &interfaceStatisticsDataSource{}
+var _ datasource.DataSourceWithConfigure = &interfaceStatisticsDataSource{}
+
+func newInterfaceStatisticsDataSource() datasource.DataSource {
+	return &interfaceStatisticsDataSource{}
+}
+
+// interfaceStatisticsDataSource defines the data source implementation.
+type interfaceStatisticsDataSource struct {
+	client opnsense.Client
+}
+
+func (d *interfaceStatisticsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_statistics"
+}
+
+func (d *interfaceStatisticsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = interfaceStatisticsDataSourceSchema()
+}
+
+func (d *interfaceStatisticsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *interfaceStatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *interfaceStatisticsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get interface statistics from OPNsense API
+	first, firstAt, err := d.sample(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read interface statistics, got error: %s", err))
+		return
+	}
+
+	device := data.Device.ValueString()
+	if device != "" && !slices.ContainsFunc(first, func(s diagnostics.InterfaceStatistics) bool { return s.Device == device }) {
+		resp.Diagnostics.AddAttributeError(path.Root("device"), "Device Not Found",
+			fmt.Sprintf("No interface statistics for device %q. Use the name of an existing interface device, e.g. vtnet0.", device))
+		return
+	}
+
+	// Sample again after the interval to calculate the throughput
+	var second []diagnostics.InterfaceStatistics
+	var elapsed time.Duration
+	if !data.SampleInterval.IsNull() {
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to sample interface statistics, got error: %s", ctx.Err()))
+			return
+		case <-time.After(time.Duration(data.SampleInterval.ValueInt64()) * time.Second):
+		}
+
+		var secondAt time.Time
+		second, secondAt, err = d.sample(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read interface statistics, got error: %s", err))
+			return
+		}
+		elapsed = secondAt.Sub(firstAt)
+	}
+
+	// Convert OPNsense struct to TF schema
+	data.Interfaces = convertInterfaceStatisticsToSchema(first, second, elapsed, device)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sample reads the interface statistics. As the counters are read at some
+// point during the request, the returned time is the middle of the request,
+// so the latency of both samples is accounted for alike.
+func (d *interfaceStatisticsDataSource) sample(ctx context.Context) ([]diagnostics.InterfaceStatistics, time.Time, error) {
+	start := time.Now()
+	stats, err := d.client.Diagnostics().GetInterfaceStatistics(ctx)
+	end := time.Now()
+	return stats, start.Add(end.Sub(start) / 2), err
+}
@@ -0,0 +1,213 @@
+package diagnostics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type interfaceStatisticsDataSourceModel struct {
+	Device         types.String `tfsdk:"device"`
+	SampleInterval types.Int64  `tfsdk:"sample_interval"`
+	Interfaces     types.List   `tfsdk:"interfaces"`
+}
+
+type interfaceStatisticsModel struct {
+	Device      types.String `tfsdk:"device"`
+	Name        types.String `tfsdk:"name"`
+	PacketsIn   types.Int64  `tfsdk:"packets_in"`
+	PacketsOut  types.Int64  `tfsdk:"packets_out"`
+	BytesIn     types.Int64  `tfsdk:"bytes_in"`
+	BytesOut    types.Int64  `tfsdk:"bytes_out"`
+	ErrorsIn    types.Int64  `tfsdk:"errors_in"`
+	ErrorsOut   types.Int64  `tfsdk:"errors_out"`
+	DropsIn     types.Int64  `tfsdk:"drops_in"`
+	DropsOut    types.Int64  `tfsdk:"drops_out"`
+	Collisions  types.Int64  `tfsdk:"collisions"`
+	BitsInRate  types.Int64  `tfsdk:"bits_in_per_second"`
+	BitsOutRate types.Int64  `tfsdk:"bits_out_per_second"`
+	PktsInRate  types.Int64  `tfsdk:"packets_in_per_second"`
+	PktsOutRate types.Int64  `tfsdk:"packets_out_per_second"`
+}
+
+var interfaceStatisticsAttrTypes = map[string]attr.Type{
+	"device":                 types.StringType,
+	"name":                   types.StringType,
+	"packets_in":             types.Int64Type,
+	"packets_out":            types.Int64Type,
+	"bytes_in":               types.Int64Type,
+	"bytes_out":              types.Int64Type,
+	"errors_in":              types.Int64Type,
+	"errors_out":             types.Int64Type,
+	"drops_in":               types.Int64Type,
+	"drops_out":              types.Int64Type,
+	"collisions":             types.Int64Type,
+	"bits_in_per_second":     types.Int64Type,
+	"bits_out_per_second":    types.Int64Type,
+	"packets_in_per_second":  types.Int64Type,
+	"packets_out_per_second": types.Int64Type,
+}
+
+func interfaceStatisticsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "InterfaceStatistics returns the traffic counters of the OPNsense interfaces, and optionally their throughput sampled over a short interval.",
+
+		Attributes: map[string]schema.Attribute{
+			"device": schema.StringAttribute{
+				MarkdownDescription: "Only return the statistics of this interface device (e.g. `vtnet0`). Reading fails if the device does not exist.",
+				Optional:            true,
+			},
+			"sample_interval": schema.Int64Attribute{
+				MarkdownDescription: "Read the counters twice, this many seconds apart, to calculate the throughput of each interface. Between `1` and `60`. Throughput is not calculated if omitted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"interfaces": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of interface statistics, sorted by device.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device": schema.StringAttribute{
+							MarkdownDescription: "Name of the interface device.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the interface, e.g. `LAN`.",
+							Computed:            true,
+						},
+						"packets_in": schema.Int64Attribute{
+							MarkdownDescription: "Packets received.",
+							Computed:            true,
+						},
+						"packets_out": schema.Int64Attribute{
+							MarkdownDescription: "Packets sent.",
+							Computed:            true,
+						},
+						"bytes_in": schema.Int64Attribute{
+							MarkdownDescription: "Bytes received.",
+							Computed:            true,
+						},
+						"bytes_out": schema.Int64Attribute{
+							MarkdownDescription: "Bytes sent.",
+							Computed:            true,
+						},
+						"errors_in": schema.Int64Attribute{
+							MarkdownDescription: "Input errors.",
+							Computed:            true,
+						},
+						"errors_out": schema.Int64Attribute{
+							MarkdownDescription: "Output errors.",
+							Computed:            true,
+						},
+						"drops_in": schema.Int64Attribute{
+							MarkdownDescription: "Received packets that were dropped.",
+							Computed:            true,
+						},
+						"drops_out": schema.Int64Attribute{
+							MarkdownDescription: "Packets that were dropped before sending.",
+							Computed:            true,
+						},
+						"collisions": schema.Int64Attribute{
+							MarkdownDescription: "Collisions.",
+							Computed:            true,
+						},
+						"bits_in_per_second": schema.Int64Attribute{
+							MarkdownDescription: "Receive throughput in bits per second over `sample_interval`, `-1` if not sampled.",
+							Computed:            true,
+						},
+						"bits_out_per_second": schema.Int64Attribute{
+							MarkdownDescription: "Send throughput in bits per second over `sample_interval`, `-1` if not sampled.",
+							Computed:            true,
+						},
+						"packets_in_per_second": schema.Int64Attribute{
+							MarkdownDescription: "Packets received per second over `sample_interval`, `-1` if not sampled.",
+							Computed:            true,
+						},
+						"packets_out_per_second": schema.Int64Attribute{
+							MarkdownDescription: "Packets sent per second over `sample_interval`, `-1` if not sampled.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// interfaceStatisticsRate returns the rate of a counter between two samples,
+// or -1 if the counter was not sampled twice or was reset in between.
+func interfaceStatisticsRate(first, second int64, elapsed time.Duration, sampled bool) int64 {
+	if !sampled || elapsed <= 0 || second < first {
+		return -1
+	}
+	return int64(float64(second-first) / elapsed.Seconds())
+}
+
+// convertInterfaceStatisticsToSchema converts the counters of the first
+// sample. If second is not nil, the rates are calculated from the difference
+// to the second sample, taken elapsed after the first.
+func convertInterfaceStatisticsToSchema(first, second []diagnostics.InterfaceStatistics, elapsed time.Duration, device string) types.List {
+	samples := map[string]diagnostics.InterfaceStatistics{}
+	for _, s := range second {
+		samples[s.Device] = s
+	}
+
+	var stats []interfaceStatisticsModel
+	for _, s := range first {
+		if device != "" && s.Device != device {
+			continue
+		}
+
+		// Counters are reported from the latest sample
+		latest, sampled := samples[s.Device]
+		if !sampled {
+			latest = s
+		}
+
+		stats = append(stats, interfaceStatisticsModel{
+			Device:      types.StringValue(latest.Device),
+			Name:        types.StringValue(latest.Name),
+			PacketsIn:   types.Int64Value(latest.PacketsIn),
+			PacketsOut:  types.Int64Value(latest.PacketsOut),
+			BytesIn:     types.Int64Value(latest.BytesIn),
+			BytesOut:    types.Int64Value(latest.BytesOut),
+			ErrorsIn:    types.Int64Value(latest.ErrorsIn),
+			ErrorsOut:   types.Int64Value(latest.ErrorsOut),
+			DropsIn:     types.Int64Value(latest.DropsIn),
+			DropsOut:    types.Int64Value(latest.DropsOut),
+			Collisions:  types.Int64Value(latest.Collisions),
+			BitsInRate:  types.Int64Value(interfaceStatisticsRate(s.BytesIn*8, latest.BytesIn*8, elapsed, sampled)),
+			BitsOutRate: types.Int64Value(interfaceStatisticsRate(s.BytesOut*8, latest.BytesOut*8, elapsed, sampled)),
+			PktsInRate:  types.Int64Value(interfaceStatisticsRate(s.PacketsIn, latest.PacketsIn, elapsed, sampled)),
+			PktsOutRate: types.Int64Value(interfaceStatisticsRate(s.PacketsOut, latest.PacketsOut, elapsed, sampled)),
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Device.ValueString() < stats[j].Device.ValueString()
+	})
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: interfaceStatisticsAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(stats) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: interfaceStatisticsAttrTypes},
+			stats,
+		)
+	}
+
+	return v
+}
@@ -0,0 +1,81 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterfaceStatisticsRate(t *testing.T) {
+	tests := []struct {
+		name          string
+		first, second int64
+		elapsed       time.Duration
+		sampled       bool
+		want          int64
+	}{
+		{name: "rate", first: 1000, second: 3000, elapsed: 2 * time.Second, sampled: true, want: 1000},
+		{name: "fractional interval", first: 0, second: 300, elapsed: 1500 * time.Millisecond, sampled: true, want: 200},
+		{name: "unchanged", first: 1000, second: 1000, elapsed: time.Second, sampled: true, want: 0},
+		{name: "not sampled", first: 1000, second: 3000, elapsed: time.Second, sampled: false, want: -1},
+		{name: "no interval", first: 1000, second: 3000, elapsed: 0, sampled: true, want: -1},
+		{name: "counter reset", first: 3000, second: 1000, elapsed: time.Second, sampled: true, want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, interfaceStatisticsRate(tt.first, tt.second, tt.elapsed, tt.sampled))
+		})
+	}
+}
+
+func TestConvertInterfaceStatisticsToSchema(t *testing.T) {
+	first := []diagnostics.InterfaceStatistics{
+		{Device: "vtnet1", Name: "WAN", PacketsIn: 100, PacketsOut: 50, BytesIn: 1000, BytesOut: 500},
+		{Device: "vtnet0", Name: "LAN", PacketsIn: 10, PacketsOut: 20, BytesIn: 100, BytesOut: 200},
+	}
+	second := []diagnostics.InterfaceStatistics{
+		{Device: "vtnet1", Name: "WAN", PacketsIn: 300, PacketsOut: 150, BytesIn: 3000, BytesOut: 1500},
+		{Device: "vtnet0", Name: "LAN", PacketsIn: 10, PacketsOut: 20, BytesIn: 100, BytesOut: 200},
+	}
+
+	convert := func(second []diagnostics.InterfaceStatistics, elapsed time.Duration, device string) []interfaceStatisticsModel {
+		var stats []interfaceStatisticsModel
+		diags := convertInterfaceStatisticsToSchema(first, second, elapsed, device).ElementsAs(context.Background(), &stats, false)
+		require.False(t, diags.HasError())
+		return stats
+	}
+
+	// Not sampled: counters of the first sample, sorted by device, no rates
+	stats := convert(nil, 0, "")
+	require.Len(t, stats, 2)
+	require.Equal(t, "vtnet0", stats[0].Device.ValueString())
+	require.Equal(t, "LAN", stats[0].Name.ValueString())
+	require.Equal(t, int64(100), stats[0].BytesIn.ValueInt64())
+	require.Equal(t, int64(-1), stats[0].BitsInRate.ValueInt64())
+	require.Equal(t, int64(-1), stats[0].PktsOutRate.ValueInt64())
+
+	// Sampled: counters of the second sample, rates from the difference
+	stats = convert(second, 2*time.Second, "")
+	require.Len(t, stats, 2)
+	require.Equal(t, "vtnet1", stats[1].Device.ValueString())
+	require.Equal(t, int64(3000), stats[1].BytesIn.ValueInt64())
+	require.Equal(t, int64(8000), stats[1].BitsInRate.ValueInt64())
+	require.Equal(t, int64(4000), stats[1].BitsOutRate.ValueInt64())
+	require.Equal(t, int64(100), stats[1].PktsInRate.ValueInt64())
+	require.Equal(t, int64(50), stats[1].PktsOutRate.ValueInt64())
+	require.Equal(t, int64(0), stats[0].BitsInRate.ValueInt64())
+
+	// Filtered by device
+	stats = convert(second, 2*time.Second, "vtnet1")
+	require.Len(t, stats, 1)
+	require.Equal(t, "WAN", stats[0].Name.ValueString())
+
+	// Unknown devices yield an empty list, not a null one
+	list := convertInterfaceStatisticsToSchema(first, nil, 0, "doesnotexist0")
+	require.False(t, list.IsNull())
+	require.Empty(t, list.Elements())
+}
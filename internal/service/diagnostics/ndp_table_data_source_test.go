@@ -0,0 +1,30 @@
+package diagnostics_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdpTableDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNeighborTableDataSourceConfig("opnsense_ndp_table"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The link-local addresses of the firewall itself are always present
+					resource.TestCheckResourceAttrWith("data.opnsense_ndp_table.all", "entries.#", testAccCheckNotEmpty),
+					resource.TestCheckResourceAttrSet("data.opnsense_ndp_table.all", "entries.0.ip_address"),
+					resource.TestCheckResourceAttrSet("data.opnsense_ndp_table.all", "entries.0.mac_address"),
+					// Filtered by the interface of the first entry
+					resource.TestCheckResourceAttrWith("data.opnsense_ndp_table.filtered", "entries.#", testAccCheckNotEmpty),
+					testAccCheckEntriesOnInterface("data.opnsense_ndp_table.filtered"),
+					resource.TestCheckResourceAttr("data.opnsense_ndp_table.none", "entries.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,140 @@
+package diagnostics
+
+import (
+	"context"
+
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type routingTableDataSourceModel struct {
+	IPProtocol types.String `tfsdk:"ip_protocol"`
+	Routes     types.List   `tfsdk:"routes"`
+}
+
+type routingTableRouteModel struct {
+	IPProtocol           types.String `tfsdk:"ip_protocol"`
+	Destination          types.String `tfsdk:"destination"`
+	Gateway              types.String `tfsdk:"gateway"`
+	Flags                types.String `tfsdk:"flags"`
+	Interface            types.String `tfsdk:"interface"`
+	InterfaceDescription types.String `tfsdk:"interface_description"`
+	MTU                  types.Int64  `tfsdk:"mtu"`
+	Expire               types.Int64  `tfsdk:"expire"`
+}
+
+var routingTableRouteAttrTypes = map[string]attr.Type{
+	"ip_protocol":           types.StringType,
+	"destination":           types.StringType,
+	"gateway":               types.StringType,
+	"flags":                 types.StringType,
+	"interface":             types.StringType,
+	"interface_description": types.StringType,
+	"mtu":                   types.Int64Type,
+	"expire":                types.Int64Type,
+}
+
+// routingTableProtocols maps the protocol of the API to the protocol of the
+// schema.
+var routingTableProtocols = map[string]string{
+	"ipv4": "inet",
+	"ipv6": "inet6",
+}
+
+func routingTableDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "RoutingTable returns the current kernel routing table of OPNsense, e.g. to verify that a route managed by `opnsense_route` is installed.",
+
+		Attributes: map[string]schema.Attribute{
+			"ip_protocol": schema.StringAttribute{
+				MarkdownDescription: "Only return routes of this address family. One of `inet` or `inet6`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("inet", "inet6"),
+				},
+			},
+			"routes": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of routes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_protocol": schema.StringAttribute{
+							MarkdownDescription: "The address family of the route, either `inet` or `inet6`.",
+							Computed:            true,
+						},
+						"destination": schema.StringAttribute{
+							MarkdownDescription: "Destination of the route, e.g. `default` or `10.0.0.0/24`.",
+							Computed:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "Next hop of the route, either an address or a link (e.g. `link#1`).",
+							Computed:            true,
+						},
+						"flags": schema.StringAttribute{
+							MarkdownDescription: "Flags of the route as shown by `netstat -r`, e.g. `UGS` for a static route to a gateway.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Interface device of the route (e.g. `vtnet0`).",
+							Computed:            true,
+						},
+						"interface_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the interface of the route.",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "MTU of the route.",
+							Computed:            true,
+						},
+						"expire": schema.Int64Attribute{
+							MarkdownDescription: "Seconds until the route expires, `-1` for routes that do not expire.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertRoutingTableToSchema(d []diagnostics.Route, ipProtocol string) types.List {
+	var routes []routingTableRouteModel
+	for _, r := range d {
+		protocol := routingTableProtocols[r.Proto]
+		if ipProtocol != "" && protocol != ipProtocol {
+			continue
+		}
+
+		routes = append(routes, routingTableRouteModel{
+			IPProtocol:           types.StringValue(protocol),
+			Destination:          types.StringValue(r.Destination),
+			Gateway:              types.StringValue(r.Gateway),
+			Flags:                types.StringValue(r.Flags),
+			Interface:            types.StringValue(r.Netif),
+			InterfaceDescription: types.StringValue(r.IntfDescription),
+			MTU:                  types.Int64Value(tools.StringToInt64(r.Mtu)),
+			Expire:               types.Int64Value(tools.StringToInt64(r.Expire)),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: routingTableRouteAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(routes) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: routingTableRouteAttrTypes},
+			routes,
+		)
+	}
+
+	return v
+}
@@ -0,0 +1,326 @@
+package firewall
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	aliasBulkFormatCSV        = "csv"
+	aliasBulkFormatJSON       = "json"
+	aliasBulkFormatPfSenseXML = "pfsense_xml"
+)
+
+var aliasBulkFormats = []string{aliasBulkFormatCSV, aliasBulkFormatJSON, aliasBulkFormatPfSenseXML}
+
+// aliasBulkTypes are the alias types that can be described by name, type,
+// content and description alone, i.e. without type specific settings such as
+// an interface or an update frequency.
+var aliasBulkTypes = []string{"host", "network", "port", "url", "urltable", "geoip", "networkgroup", "mac", "asn"}
+
+// pfSenseAliasTypes are the alias types shared by pfSense and OPNsense.
+var pfSenseAliasTypes = []string{"host", "network", "port", "url", "urltable"}
+
+var aliasBulkNameRegex = regexp.MustCompile(`^(?:[a-zA-Z]|_[a-zA-Z0-9])[a-zA-Z0-9_]{0,29}$`)
+
+// bulkAlias is a single alias in a bulk alias document.
+type bulkAlias struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Content     []string `json:"content"`
+	Description string   `json:"description,omitempty"`
+}
+
+// parseAliasBulk parses source in the given format. The returned aliases are
+// sorted by name, with duplicate content entries removed.
+func parseAliasBulk(format string, source string) ([]bulkAlias, error) {
+	var aliases []bulkAlias
+	var err error
+
+	switch format {
+	case aliasBulkFormatCSV:
+		aliases, err = parseAliasBulkCSV(source)
+	case aliasBulkFormatJSON:
+		aliases, err = parseAliasBulkJSON(source)
+	case aliasBulkFormatPfSenseXML:
+		aliases, err = parseAliasBulkPfSenseXML(source)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for i := range aliases {
+		a := &aliases[i]
+
+		if !aliasBulkNameRegex.MatchString(a.Name) {
+			return nil, fmt.Errorf("alias %q: name must start with a letter or single underscore, be less than 32 characters and only consist of alphanumeric characters or underscores", a.Name)
+		}
+		if seen[a.Name] {
+			return nil, fmt.Errorf("alias %q: defined more than once", a.Name)
+		}
+		seen[a.Name] = true
+
+		if !slices.Contains(aliasBulkTypes, a.Type) {
+			return nil, fmt.Errorf("alias %q: unsupported type %q, must be one of: %s", a.Name, a.Type, strings.Join(aliasBulkTypes, ", "))
+		}
+
+		a.Content = uniqueStrings(a.Content)
+	}
+
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+	return aliases, nil
+}
+
+// formatAliasBulk writes aliases in the given format, such that
+// parseAliasBulk returns the same aliases.
+func formatAliasBulk(format string, aliases []bulkAlias) (string, error) {
+	switch format {
+	case aliasBulkFormatCSV:
+		return formatAliasBulkCSV(aliases)
+	case aliasBulkFormatJSON:
+		return formatAliasBulkJSON(aliases)
+	case aliasBulkFormatPfSenseXML:
+		return formatAliasBulkPfSenseXML(aliases)
+	default:
+		return "", fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// parseAliasBulkCSV parses a CSV document with a header row. The `name`,
+// `type` and `content` columns are required, `description` is optional.
+// Content entries are separated by whitespace, and rows with the same name
+// are merged into a single alias.
+func parseAliasBulkCSV(source string) ([]bulkAlias, error) {
+	reader := csv.NewReader(strings.NewReader(source))
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return []bulkAlias{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse CSV: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains([]string{"name", "type", "content", "description"}, column) {
+			return nil, fmt.Errorf("unable to parse CSV: unknown column %q", column)
+		}
+		columns[column] = i
+	}
+	for _, column := range []string{"name", "type", "content"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("unable to parse CSV: missing column %q", column)
+		}
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	aliases := []bulkAlias{}
+	index := map[string]int{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse CSV: %w", err)
+		}
+
+		name := field(record, "name")
+		aliasType := field(record, "type")
+		description := field(record, "description")
+		content := strings.Fields(field(record, "content"))
+
+		i, ok := index[name]
+		if !ok {
+			index[name] = len(aliases)
+			aliases = append(aliases, bulkAlias{
+				Name:        name,
+				Type:        aliasType,
+				Content:     content,
+				Description: description,
+			})
+			continue
+		}
+
+		a := &aliases[i]
+		if a.Type != aliasType {
+			return nil, fmt.Errorf("alias %q: rows have different types %q and %q", name, a.Type, aliasType)
+		}
+		if a.Description == "" {
+			a.Description = description
+		}
+		a.Content = append(a.Content, content...)
+	}
+
+	return aliases, nil
+}
+
+func formatAliasBulkCSV(aliases []bulkAlias) (string, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	records := [][]string{{"name", "type", "content", "description"}}
+	for _, a := range aliases {
+		records = append(records, []string{a.Name, a.Type, strings.Join(a.Content, " "), a.Description})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// parseAliasBulkJSON parses a JSON array of aliases, each with a `name`,
+// `type`, `content` (array of strings) and optional `description`.
+func parseAliasBulkJSON(source string) ([]bulkAlias, error) {
+	decoder := json.NewDecoder(strings.NewReader(source))
+	decoder.DisallowUnknownFields()
+
+	aliases := []bulkAlias{}
+	if err := decoder.Decode(&aliases); err != nil {
+		return nil, fmt.Errorf("unable to parse JSON: %w", err)
+	}
+
+	for i := range aliases {
+		for j := range aliases[i].Content {
+			aliases[i].Content[j] = strings.TrimSpace(aliases[i].Content[j])
+		}
+	}
+
+	return aliases, nil
+}
+
+func formatAliasBulkJSON(aliases []bulkAlias) (string, error) {
+	// Always write content as an array, even if empty
+	out := make([]bulkAlias, len(aliases))
+	for i, a := range aliases {
+		out[i] = a
+		if out[i].Content == nil {
+			out[i].Content = []string{}
+		}
+	}
+
+	content, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(content) + "\n", nil
+}
+
+// pfSenseConfig is a pfSense configuration backup, or the aliases section of
+// one.
+type pfSenseConfig struct {
+	XMLName xml.Name
+	Aliases []pfSenseAlias `xml:"aliases>alias"`
+	Alias   []pfSenseAlias `xml:"alias"`
+}
+
+type pfSenseAlias struct {
+	Name        string `xml:"name"`
+	Type        string `xml:"type"`
+	Address     string `xml:"address"`
+	URL         string `xml:"url,omitempty"`
+	Description string `xml:"descr,omitempty"`
+}
+
+// parseAliasBulkPfSenseXML parses the aliases of a pfSense configuration
+// backup (`<pfsense><aliases>...`), or an aliases-only export
+// (`<aliases>...`). Content is read from `<address>`, or from `<url>` for URL
+// table aliases without an address.
+func parseAliasBulkPfSenseXML(source string) ([]bulkAlias, error) {
+	var config pfSenseConfig
+	if err := xml.Unmarshal([]byte(source), &config); err != nil {
+		return nil, fmt.Errorf("unable to parse pfSense XML: %w", err)
+	}
+
+	var pfSenseAliases []pfSenseAlias
+	switch config.XMLName.Local {
+	case "pfsense":
+		pfSenseAliases = config.Aliases
+	case "aliases":
+		pfSenseAliases = config.Alias
+	default:
+		return nil, fmt.Errorf("unable to parse pfSense XML: unexpected root element <%s>, expected <pfsense> or <aliases>", config.XMLName.Local)
+	}
+
+	aliases := []bulkAlias{}
+	for _, a := range pfSenseAliases {
+		name := strings.TrimSpace(a.Name)
+		aliasType := strings.TrimSpace(a.Type)
+		if !slices.Contains(pfSenseAliasTypes, aliasType) {
+			return nil, fmt.Errorf("alias %q: unsupported pfSense alias type %q, must be one of: %s", name, aliasType, strings.Join(pfSenseAliasTypes, ", "))
+		}
+
+		address := a.Address
+		if strings.TrimSpace(address) == "" {
+			address = a.URL
+		}
+
+		aliases = append(aliases, bulkAlias{
+			Name:        name,
+			Type:        aliasType,
+			Content:     strings.Fields(address),
+			Description: strings.TrimSpace(a.Description),
+		})
+	}
+
+	return aliases, nil
+}
+
+func formatAliasBulkPfSenseXML(aliases []bulkAlias) (string, error) {
+	config := pfSenseConfig{XMLName: xml.Name{Local: "pfsense"}}
+	for _, a := range aliases {
+		if !slices.Contains(pfSenseAliasTypes, a.Type) {
+			return "", fmt.Errorf("alias %q: type %q is not supported by pfSense", a.Name, a.Type)
+		}
+
+		config.Aliases = append(config.Aliases, pfSenseAlias{
+			Name:        a.Name,
+			Type:        a.Type,
+			Address:     strings.Join(a.Content, " "),
+			Description: a.Description,
+		})
+	}
+
+	content, err := xml.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return xml.Header + string(content) + "\n", nil
+}
+
+// uniqueStrings returns s without empty and duplicate entries, keeping the
+// first occurrence of each.
+func uniqueStrings(s []string) []string {
+	result := []string{}
+	for _, v := range s {
+		if v != "" && !slices.Contains(result, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
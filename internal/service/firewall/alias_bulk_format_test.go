@@ -0,0 +1,90 @@
+package firewall
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// aliasBulkFixture is the content of every document in testdata/alias_bulk.
+var aliasBulkFixture = []bulkAlias{
+	{
+		Name:        "blocklist",
+		Type:        "urltable",
+		Content:     []string{"https://example.com/drop.txt"},
+		Description: "Drop list, updated daily",
+	},
+	{
+		Name:        "office_nets",
+		Type:        "network",
+		Content:     []string{"10.0.0.0/8", "192.168.0.0/16", "172.16.0.0/12"},
+		Description: "Office networks",
+	},
+	{
+		Name:        "web_ports",
+		Type:        "port",
+		Content:     []string{"80", "443"},
+		Description: "Web ports",
+	},
+}
+
+func TestParseAliasBulk(t *testing.T) {
+	tests := map[string]string{
+		aliasBulkFormatCSV:        "aliases.csv",
+		aliasBulkFormatJSON:       "aliases.json",
+		aliasBulkFormatPfSenseXML: "aliases.xml",
+	}
+
+	for format, file := range tests {
+		t.Run(format, func(t *testing.T) {
+			source, err := os.ReadFile(filepath.Join("testdata", "alias_bulk", file))
+			require.NoError(t, err)
+
+			aliases, err := parseAliasBulk(format, string(source))
+			require.NoError(t, err)
+			require.Equal(t, aliasBulkFixture, aliases)
+
+			// Round-trip through the export format
+			exported, err := formatAliasBulk(format, aliases)
+			require.NoError(t, err)
+
+			reparsed, err := parseAliasBulk(format, exported)
+			require.NoError(t, err)
+			require.Equal(t, aliases, reparsed)
+		})
+	}
+}
+
+func TestParseAliasBulkErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		source string
+		err    string
+	}{
+		{"csv missing column", aliasBulkFormatCSV, "name,content\na,1.1.1.1\n", `missing column "type"`},
+		{"csv unknown column", aliasBulkFormatCSV, "name,type,content,comment\n", `unknown column "comment"`},
+		{"csv type mismatch", aliasBulkFormatCSV, "name,type,content\na,host,1.1.1.1\na,network,10.0.0.0/8\n", "different types"},
+		{"json duplicate", aliasBulkFormatJSON, `[{"name":"a","type":"host"},{"name":"a","type":"host"}]`, "defined more than once"},
+		{"json unknown field", aliasBulkFormatJSON, `[{"name":"a","type":"host","enabled":true}]`, "unknown field"},
+		{"invalid name", aliasBulkFormatJSON, `[{"name":"1a","type":"host"}]`, "name must start with"},
+		{"invalid type", aliasBulkFormatJSON, `[{"name":"a","type":"dynipv6host"}]`, `unsupported type "dynipv6host"`},
+		{"xml root", aliasBulkFormatPfSenseXML, `<opnsense/>`, "unexpected root element <opnsense>"},
+		{"xml type", aliasBulkFormatPfSenseXML, `<aliases><alias><name>a</name><type>url_ports</type></alias></aliases>`, `unsupported pfSense alias type "url_ports"`},
+		{"format", "yaml", "", `unsupported format "yaml"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseAliasBulk(tt.format, tt.source)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestFormatAliasBulkPfSenseXMLUnsupportedType(t *testing.T) {
+	_, err := formatAliasBulk(aliasBulkFormatPfSenseXML, []bulkAlias{{Name: "nl", Type: "geoip", Content: []string{"NL"}}})
+	require.ErrorContains(t, err, "not supported by pfSense")
+}
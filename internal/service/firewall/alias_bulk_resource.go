@@ -0,0 +1,359 @@
+package firewall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &aliasBulkResource{}
+var _ resource.ResourceWithConfigure = &aliasBulkResource{}
+var _ resource.ResourceWithImportState = &aliasBulkResource{}
+var _ resource.ResourceWithModifyPlan = &aliasBulkResource{}
+
+func newAliasBulkResource() resource.Resource {
+	return &aliasBulkResource{}
+}
+
+// aliasBulkResource defines the resource implementation.
+type aliasBulkResource struct {
+	client opnsense.Client
+}
+
+func (r *aliasBulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_alias_bulk"
+}
+
+func (r *aliasBulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = aliasBulkResourceSchema()
+}
+
+func (r *aliasBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *aliasBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *aliasBulkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aliases, err := parseAliasBulk(data.Format.ValueString(), data.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Alias Source",
+			fmt.Sprintf("Unable to parse firewall aliases, got error: %s", err))
+		return
+	}
+
+	// Import all aliases in one call
+	resp.Diagnostics.Append(r.importAliases(ctx, data, aliases)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *aliasBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *aliasBulkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Managed alias names, from the ID when importing
+	stateAliases, _, diags := aliasBulkAliasesFromMap(ctx, data.Aliases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var names []string
+	for _, a := range stateAliases {
+		names = append(names, a.Name)
+	}
+	if data.Aliases.IsNull() {
+		names = strings.Split(data.Id.ValueString(), ",")
+	}
+
+	// Get firewall aliases from OPNsense API
+	remoteAliases, err := r.client.Firewall().ListAliases(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall aliases, got error: %s", err))
+		return
+	}
+
+	aliases := []bulkAlias{}
+	ids := map[string]string{}
+	for id, remoteAlias := range remoteAliases {
+		if slices.Contains(names, remoteAlias.Name) {
+			aliases = append(aliases, convertAliasStructToBulkAlias(&remoteAlias))
+			ids[remoteAlias.Name] = id
+		}
+	}
+
+	if len(aliases) == 0 {
+		tflog.Warn(ctx, "firewall aliases not present in remote, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Aliases removed outside Terraform are dropped, so the next plan
+	// recreates them
+	data.Aliases, diags = aliasBulkAliasesToMap(ctx, aliases, ids)
+	resp.Diagnostics.Append(diags...)
+	data.Id = types.StringValue(aliasBulkId(slices.Collect(maps.Keys(ids))))
+
+	// Not stored in OPNsense, keep the configured value (false on import)
+	data.AdoptExisting = types.BoolValue(data.AdoptExisting.ValueBool())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *aliasBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *aliasBulkResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aliases, err := parseAliasBulk(data.Format.ValueString(), data.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Alias Source",
+			fmt.Sprintf("Unable to parse firewall aliases, got error: %s", err))
+		return
+	}
+
+	// Import all aliases in one call, updating existing ones by name
+	resp.Diagnostics.Append(r.importAliases(ctx, data, aliases)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete aliases that were removed from the source
+	_, stateIds, diags := aliasBulkAliasesFromMap(ctx, state.Aliases)
+	resp.Diagnostics.Append(diags...)
+	for name, id := range stateIds {
+		if slices.ContainsFunc(aliases, func(a bulkAlias) bool { return a.Name == name }) {
+			continue
+		}
+
+		err := r.client.Firewall().DeleteAlias(ctx, id)
+		var notFoundError *errs.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to delete firewall alias %q, got error: %s", name, err))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *aliasBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *aliasBulkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, ids, diags := aliasBulkAliasesFromMap(ctx, data.Aliases)
+	resp.Diagnostics.Append(diags...)
+	for name, id := range ids {
+		err := r.client.Firewall().DeleteAlias(ctx, id)
+		var notFoundError *errs.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to delete firewall alias %q, got error: %s", name, err))
+		}
+	}
+}
+
+func (r *aliasBulkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *aliasBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The aliases can't be known until the source is
+	if plan.Format.IsUnknown() || plan.Source.IsUnknown() {
+		plan.Aliases = types.MapUnknown(types.ObjectType{AttrTypes: aliasBulkAliasAttrTypes})
+		plan.Id = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	aliases, err := parseAliasBulk(plan.Format.ValueString(), plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Alias Source",
+			fmt.Sprintf("Unable to parse firewall aliases, got error: %s", err))
+		return
+	}
+
+	// Existing aliases keep their ID, so the plan shows changes per alias
+	ids := map[string]string{}
+	if !req.State.Raw.IsNull() {
+		var state *aliasBulkResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var diags diag.Diagnostics
+		_, ids, diags = aliasBulkAliasesFromMap(ctx, state.Aliases)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	var names []string
+	for _, a := range aliases {
+		names = append(names, a.Name)
+	}
+
+	// Loading an alias overwrites any alias with the same name, so don't
+	// take over aliases managed elsewhere unless asked to
+	if !plan.AdoptExisting.ValueBool() && r.client != nil {
+		resp.Diagnostics.Append(r.checkExistingAliases(ctx, names, ids)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var diags diag.Diagnostics
+	plan.Aliases, diags = aliasBulkAliasesToMap(ctx, aliases, ids)
+	resp.Diagnostics.Append(diags...)
+	plan.Id = types.StringValue(aliasBulkId(names))
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *aliasBulkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkExistingAliases returns an error for each name that belongs to an
+// alias in OPNsense that is not in ids, the aliases managed by this resource.
+func (r *aliasBulkResource) checkExistingAliases(ctx context.Context, names []string, ids map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	remoteAliases, err := r.client.Firewall().ListAliases(ctx)
+	if err != nil {
+		diags.AddWarning("Unable to Check Existing Aliases",
+			fmt.Sprintf("Unable to read firewall aliases, got error: %s", err))
+		return diags
+	}
+
+	remoteNames := map[string]bool{}
+	for _, remoteAlias := range remoteAliases {
+		remoteNames[remoteAlias.Name] = true
+	}
+
+	for _, name := range names {
+		if _, ok := ids[name]; ok || !remoteNames[name] {
+			continue
+		}
+		diags.AddAttributeError(path.Root("source"), "Alias Already Exists",
+			fmt.Sprintf("Firewall alias %q already exists and is not managed by this resource. Loading it would overwrite it.\n\nRemove it from the source, or set adopt_existing to take it over.", name))
+	}
+
+	return diags
+}
+
+// importAliases loads aliases with the alias import API, then sets the
+// aliases and ID of data from the resulting aliases.
+func (r *aliasBulkResource) importAliases(ctx context.Context, data *aliasBulkResourceModel, aliases []bulkAlias) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceStructs := []*firewall.Alias{}
+	for _, a := range aliases {
+		resourceStructs = append(resourceStructs, convertBulkAliasToStruct(a))
+	}
+
+	if err := r.client.Firewall().ImportAliases(ctx, resourceStructs); err != nil {
+		diags.AddError("Client Error",
+			fmt.Sprintf("Unable to import firewall aliases, got error: %s", err))
+		return diags
+	}
+
+	// Look up the UUIDs of the imported aliases
+	remoteAliases, err := r.client.Firewall().ListAliases(ctx)
+	if err != nil {
+		diags.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall aliases, got error: %s", err))
+		return diags
+	}
+
+	ids := map[string]string{}
+	for id, remoteAlias := range remoteAliases {
+		ids[remoteAlias.Name] = id
+	}
+
+	var names []string
+	for _, a := range aliases {
+		if _, ok := ids[a.Name]; !ok {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to import firewall aliases, alias %q not present in remote after import", a.Name))
+		}
+		names = append(names, a.Name)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Aliases, diags = aliasBulkAliasesToMap(ctx, aliases, ids)
+	data.Id = types.StringValue(aliasBulkId(names))
+
+	return diags
+}
@@ -0,0 +1,146 @@
+package firewall_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFirewallAliasBulkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallAliasBulkResourceConfig("csv", `name,type,content,description
+tf_bulk_hosts,host,192.0.2.10 192.0.2.11,Bulk hosts
+tf_bulk_ports,port,80 443,Bulk ports
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "id", "tf_bulk_hosts,tf_bulk_ports"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.%", "2"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_hosts.type", "host"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_hosts.description", "Bulk hosts"),
+					resource.TestCheckTypeSetElemAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_hosts.content.*", "192.0.2.11"),
+					resource.TestCheckResourceAttrSet("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_hosts.id"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_ports.content.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_firewall_alias_bulk.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"format", "source"},
+			},
+			// Update and Read testing: change one alias, remove one, add one
+			{
+				Config: testAccFirewallAliasBulkResourceConfig("json", `[
+  {"name": "tf_bulk_hosts", "type": "host", "content": ["192.0.2.10"], "description": "Bulk hosts"},
+  {"name": "tf_bulk_nets", "type": "network", "content": ["198.51.100.0/24"]}
+]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "id", "tf_bulk_hosts,tf_bulk_nets"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.%", "2"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_hosts.content.#", "1"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_nets.type", "network"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_nets.description", ""),
+				),
+			},
+			// Export the managed aliases, and load them back unchanged
+			{
+				Config: testAccFirewallAliasBulkResourceConfig("json", `[
+  {"name": "tf_bulk_hosts", "type": "host", "content": ["192.0.2.10"], "description": "Bulk hosts"},
+  {"name": "tf_bulk_nets", "type": "network", "content": ["198.51.100.0/24"]}
+]`) + `
+data "opnsense_firewall_alias_export" "test" {
+  format = "pfsense_xml"
+  names  = keys(opnsense_firewall_alias_bulk.test.aliases)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opnsense_firewall_alias_export.test", "aliases.#", "2"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_alias_export.test", "aliases.0", "tf_bulk_hosts"),
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_alias_export.test", "content"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFirewallAliasBulkResource_Existing(t *testing.T) {
+	source := `name,type,content
+tf_bulk_existing,host,192.0.2.20
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		// Needs removed blocks
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_7_0)},
+		Steps: []resource.TestStep{
+			// Create an alias outside the bulk resource
+			{
+				Config: testAccFirewallAliasBulkResourceConfigExisting,
+			},
+			// Loading an alias that is not managed by the resource fails the plan
+			{
+				Config: testAccFirewallAliasBulkResourceConfigExisting + testAccFirewallAliasBulkResourceConfigAdopt(source, false),
+				ExpectError: regexp.MustCompile(
+					`Firewall alias "tf_bulk_existing" already exists and is not managed by this\s+resource`),
+			},
+			// Unless it is adopted
+			{
+				Config: `
+removed {
+  from = opnsense_firewall_alias.existing
+
+  lifecycle {
+    destroy = false
+  }
+}
+` + testAccFirewallAliasBulkResourceConfigAdopt(source, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_existing.type", "host"),
+					resource.TestCheckTypeSetElemAttr("opnsense_firewall_alias_bulk.test", "aliases.tf_bulk_existing.content.*", "192.0.2.20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallAliasBulkResourceConfig(format, source string) string {
+	return fmt.Sprintf(`
+resource "opnsense_firewall_alias_bulk" "test" {
+  format = %q
+  source = <<-EOT
+%s
+EOT
+}
+`, format, source)
+}
+
+const testAccFirewallAliasBulkResourceConfigExisting = `
+resource "opnsense_firewall_alias" "existing" {
+  name    = "tf_bulk_existing"
+  type    = "host"
+  content = ["192.0.2.1"]
+}
+`
+
+func testAccFirewallAliasBulkResourceConfigAdopt(source string, adopt bool) string {
+	return fmt.Sprintf(`
+resource "opnsense_firewall_alias_bulk" "test" {
+  format         = "csv"
+  adopt_existing = %t
+  source         = <<-EOT
+%s
+EOT
+}
+`, adopt, source)
+}
@@ -0,0 +1,186 @@
+package firewall
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// aliasBulkResourceModel describes the resource data model.
+type aliasBulkResourceModel struct {
+	Format        types.String `tfsdk:"format"`
+	Source        types.String `tfsdk:"source"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	Aliases       types.Map    `tfsdk:"aliases"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// aliasBulkAliasModel is a single entry of aliasBulkResourceModel.Aliases,
+// keyed by alias name.
+type aliasBulkAliasModel struct {
+	Type        types.String `tfsdk:"type"`
+	Content     types.Set    `tfsdk:"content"`
+	Description types.String `tfsdk:"description"`
+	Id          types.String `tfsdk:"id"`
+}
+
+var aliasBulkAliasAttrTypes = map[string]attr.Type{
+	"type":        types.StringType,
+	"content":     types.SetType{ElemType: types.StringType},
+	"description": types.StringType,
+	"id":          types.StringType,
+}
+
+func aliasBulkResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manages a set of firewall aliases loaded from a CSV, JSON or pfSense XML document. All aliases are loaded with a single call to the alias import API, and changes are planned per alias.",
+
+		Attributes: map[string]schema.Attribute{
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of `source`. One of `csv`, `json`, or `pfsense_xml`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(aliasBulkFormats...),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The document to load aliases from, usually read with `file()`.\n\n" +
+					"  - `csv`: a header row with the columns `name`, `type`, `content` and optionally `description`. Content entries are separated by whitespace, and rows with the same name are merged into one alias. Lines starting with `#` are ignored.\n" +
+					"  - `json`: an array of objects with the keys `name`, `type`, `content` (array of strings) and optionally `description`.\n" +
+					"  - `pfsense_xml`: a pfSense configuration backup, or its `<aliases>` section. Supports the `host`, `network`, `port`, `url` and `urltable` alias types.\n\n" +
+					"Supported alias types are `host`, `network`, `port`, `url`, `urltable`, `geoip`, `networkgroup`, `mac` and `asn`. Aliases are created enabled, with the defaults of `opnsense_firewall_alias` for all other settings.",
+				Required: true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "If set, aliases in `source` that already exist in OPNsense, but are not managed by this resource, are overwritten and taken over. Otherwise, planning fails if `source` contains such an alias. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"aliases": schema.MapNestedAttribute{
+				MarkdownDescription: "The aliases parsed from `source`, keyed by alias name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of alias.",
+							Computed:            true,
+						},
+						"content": schema.SetAttribute{
+							MarkdownDescription: "The content of the alias.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the alias.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the alias.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated names of the managed aliases.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// aliasBulkId returns the resource ID for a set of alias names.
+func aliasBulkId(names []string) string {
+	names = append([]string{}, names...)
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func convertBulkAliasToStruct(a bulkAlias) *firewall.Alias {
+	return &firewall.Alias{
+		Enabled:     "1",
+		Name:        a.Name,
+		Type:        api.SelectedMap(a.Type),
+		IPProtocol:  []string{"IPv4"},
+		Content:     a.Content,
+		Categories:  []string{},
+		UpdateFreq:  tools.Float64ToStringNegative(-1),
+		Statistics:  "0",
+		Description: a.Description,
+	}
+}
+
+func convertAliasStructToBulkAlias(a *firewall.Alias) bulkAlias {
+	return bulkAlias{
+		Name:        a.Name,
+		Type:        a.Type.String(),
+		Content:     uniqueStrings(a.Content),
+		Description: a.Description,
+	}
+}
+
+// aliasBulkAliasesToMap converts aliases to the `aliases` attribute. ids maps
+// alias names to their UUID; aliases without an ID get an unknown one.
+func aliasBulkAliasesToMap(ctx context.Context, aliases []bulkAlias, ids map[string]string) (types.Map, diag.Diagnostics) {
+	elements := map[string]aliasBulkAliasModel{}
+	for _, a := range aliases {
+		id := types.StringUnknown()
+		if v, ok := ids[a.Name]; ok {
+			id = types.StringValue(v)
+		}
+
+		elements[a.Name] = aliasBulkAliasModel{
+			Type:        types.StringValue(a.Type),
+			Content:     tools.StringSliceToSet(a.Content),
+			Description: types.StringValue(a.Description),
+			Id:          id,
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: aliasBulkAliasAttrTypes}, elements)
+}
+
+// aliasBulkAliasesFromMap converts the `aliases` attribute back to aliases,
+// sorted by name, and a map of alias names to their UUID.
+func aliasBulkAliasesFromMap(ctx context.Context, m types.Map) ([]bulkAlias, map[string]string, diag.Diagnostics) {
+	aliases := []bulkAlias{}
+	ids := map[string]string{}
+
+	if m.IsNull() || m.IsUnknown() {
+		return aliases, ids, nil
+	}
+
+	var elements map[string]aliasBulkAliasModel
+	diags := m.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	for name, e := range elements {
+		aliases = append(aliases, bulkAlias{
+			Name:        name,
+			Type:        e.Type.ValueString(),
+			Content:     tools.SetToStringSlice(e.Content),
+			Description: e.Description.ValueString(),
+		})
+		if !e.Id.IsNull() && !e.Id.IsUnknown() {
+			ids[name] = e.Id.ValueString()
+		}
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+
+	return aliases, ids, diags
+}
@@ -0,0 +1,111 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &aliasExportDataSource{}
+var _ datasource.DataSourceWithConfigure = &aliasExportDataSource{}
+
+func newAliasExportDataSource() datasource.DataSource {
+	return &aliasExportDataSource{}
+}
+
+// aliasExportDataSource defines the data source implementation.
+type aliasExportDataSource struct {
+	client opnsense.Client
+}
+
+func (d *aliasExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_alias_export"
+}
+
+func (d *aliasExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = aliasExportDataSourceSchema()
+}
+
+func (d *aliasExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *aliasExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *aliasExportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get firewall aliases from OPNsense API
+	remoteAliases, err := d.client.Firewall().ListAliases(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall aliases, got error: %s", err))
+		return
+	}
+
+	// Only keep the requested aliases, of types the format supports
+	format := data.Format.ValueString()
+	supportedTypes := aliasBulkTypes
+	if format == aliasBulkFormatPfSenseXML {
+		supportedTypes = pfSenseAliasTypes
+	}
+
+	names := tools.SetToStringSlice(data.Names)
+	aliases := []bulkAlias{}
+	for _, remoteAlias := range remoteAliases {
+		if len(names) > 0 && !slices.Contains(names, remoteAlias.Name) {
+			continue
+		}
+		if !slices.Contains(supportedTypes, remoteAlias.Type.String()) {
+			continue
+		}
+		aliases = append(aliases, convertAliasStructToBulkAlias(&remoteAlias))
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+
+	content, err := formatAliasBulk(format, aliases)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("format"), "Client Error",
+			fmt.Sprintf("Unable to export firewall aliases, got error: %s", err))
+		return
+	}
+
+	exported := []string{}
+	for _, a := range aliases {
+		exported = append(exported, a.Name)
+	}
+
+	data.Content = types.StringValue(content)
+	data.Aliases, _ = types.ListValueFrom(ctx, types.StringType, exported)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,42 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallAliasExportDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallAliasExportDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opnsense_firewall_alias_export.test", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_alias_export.test", "aliases.0", "tf_export_hosts"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_alias_export.test", "content",
+						"name,type,content,description\ntf_export_hosts,host,192.0.2.20,Export hosts\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallAliasExportDataSourceConfig() string {
+	return `
+resource "opnsense_firewall_alias" "test" {
+  name        = "tf_export_hosts"
+  type        = "host"
+  content     = ["192.0.2.20"]
+  description = "Export hosts"
+}
+
+data "opnsense_firewall_alias_export" "test" {
+  format = "csv"
+  names  = [opnsense_firewall_alias.test.name]
+}
+`
+}
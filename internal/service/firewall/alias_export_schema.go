@@ -0,0 +1,45 @@
+package firewall
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type aliasExportDataSourceModel struct {
+	Format  types.String `tfsdk:"format"`
+	Names   types.Set    `tfsdk:"names"`
+	Content types.String `tfsdk:"content"`
+	Aliases types.List   `tfsdk:"aliases"`
+}
+
+func aliasExportDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Exports firewall aliases as a CSV, JSON or pfSense XML document, in the same formats `opnsense_firewall_alias_bulk` loads. Only the name, type, content and description of each alias are exported.",
+
+		Attributes: map[string]schema.Attribute{
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of `content`. One of `csv`, `json`, or `pfsense_xml`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(aliasBulkFormats...),
+				},
+			},
+			"names": schema.SetAttribute{
+				MarkdownDescription: "Set of alias names to export. Exports all aliases if omitted.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The exported document. Aliases are sorted by name.",
+				Computed:            true,
+			},
+			"aliases": schema.ListAttribute{
+				MarkdownDescription: "Names of the exported aliases. Aliases of a type the format does not support (e.g. `geoip` in `pfsense_xml`, or `dynipv6host` in any format) are not exported.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
@@ -49,7 +49,7 @@ func (d *categoryDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *categoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *categoryResourceModel
+	var data *categoryModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -0,0 +1,77 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallCategoryMembershipDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallCategoryMembershipDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_category.test", "prevent_destroy_if_in_use", "true"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_category_membership.test", "members.#", "3"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_category_membership.test", "members.0.type", "alias"),
+					resource.TestCheckResourceAttrPair("data.opnsense_firewall_category_membership.test", "members.0.id", "opnsense_firewall_alias.test", "id"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_category_membership.test", "members.0.description", "tf_category_members"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_category_membership.test", "members.1.type", "filter"),
+					resource.TestCheckResourceAttrPair("data.opnsense_firewall_category_membership.test", "members.1.id", "opnsense_firewall_filter.test", "id"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_category_membership.test", "members.2.type", "npt"),
+					resource.TestCheckResourceAttrPair("data.opnsense_firewall_category_membership.test", "members.2.id", "opnsense_firewall_npt.test", "id"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_category_membership.test", "members.2.description", "Testing category members"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallCategoryMembershipDataSourceConfig() string {
+	return `
+resource "opnsense_firewall_category" "test" {
+  name                      = "tf-category-members"
+  prevent_destroy_if_in_use = true
+}
+
+resource "opnsense_firewall_alias" "test" {
+  name       = "tf_category_members"
+  type       = "host"
+  content    = ["192.0.2.1"]
+  categories = [opnsense_firewall_category.test.id]
+}
+
+resource "opnsense_firewall_filter" "test" {
+  description = "Testing category members"
+  categories  = [opnsense_firewall_category.test.id]
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action = "pass"
+    source = {
+      net = opnsense_firewall_alias.test.name
+    }
+  }
+}
+
+resource "opnsense_firewall_npt" "test" {
+  internal_prefix = "fd00:1::/64"
+  external_prefix = "2001:db8:1::/64"
+  description     = "Testing category members"
+  categories      = [opnsense_firewall_category.test.id]
+}
+
+data "opnsense_firewall_category_membership" "test" {
+  id = opnsense_firewall_category.test.id
+
+  depends_on = [opnsense_firewall_alias.test, opnsense_firewall_filter.test, opnsense_firewall_npt.test]
+}
+`
+}
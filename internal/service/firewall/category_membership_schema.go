@@ -0,0 +1,90 @@
+package firewall
+
+import (
+	"context"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type categoryMembershipDataSourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Members types.List   `tfsdk:"members"`
+}
+
+type categoryMemberModel struct {
+	Type        types.String `tfsdk:"type"`
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+}
+
+var categoryMemberAttrTypes = map[string]attr.Type{
+	"type":        types.StringType,
+	"id":          types.StringType,
+	"description": types.StringType,
+}
+
+func categoryMembershipDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Lists the aliases, filter rules, NAT rules, port forwards, 1:1 NAT rules and NPT rules assigned to a firewall category.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the category.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IsUUIDv4(),
+				},
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "The objects assigned to the category, sorted by type and description.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the object. One of `alias`, `filter`, `nat`, `nat_port_forward`, `nat_one_to_one`, or `npt`.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the object.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Name of the alias, or description of the rule.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertCategoryMembersToSchema(members []categoryMember) types.List {
+	var models []categoryMemberModel
+	for _, m := range members {
+		models = append(models, categoryMemberModel{
+			Type:        types.StringValue(m.Type),
+			Id:          types.StringValue(m.Id),
+			Description: types.StringValue(m.Description),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: categoryMemberAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(models) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: categoryMemberAttrTypes},
+			models,
+		)
+	}
+	return v
+}
@@ -0,0 +1,112 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+)
+
+const (
+	categoryMemberAlias          = "alias"
+	categoryMemberFilter         = "filter"
+	categoryMemberNAT            = "nat"
+	categoryMemberNATPortForward = "nat_port_forward"
+	categoryMemberNATOneToOne    = "nat_one_to_one"
+	categoryMemberNPT            = "npt"
+)
+
+// categoryMember is an object that is assigned to a category.
+type categoryMember struct {
+	Type        string
+	Id          string
+	Description string
+}
+
+func (m categoryMember) String() string {
+	if m.Description == "" {
+		return fmt.Sprintf("%s %s", m.Type, m.Id)
+	}
+	return fmt.Sprintf("%s %s (%s)", m.Type, m.Id, m.Description)
+}
+
+// listCategoryMembers returns the aliases, filter rules, NAT rules, port
+// forwards, 1:1 NAT rules and NPT rules assigned to the category with the
+// given UUID, sorted by type and description.
+func listCategoryMembers(ctx context.Context, client opnsense.Client, categoryId string) ([]categoryMember, error) {
+	members := []categoryMember{}
+
+	aliases, err := client.Firewall().ListAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall aliases: %w", err)
+	}
+	for id, alias := range aliases {
+		if slices.Contains(alias.Categories, categoryId) {
+			members = append(members, categoryMember{Type: categoryMemberAlias, Id: id, Description: alias.Name})
+		}
+	}
+
+	filters, err := client.Firewall().ListFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall filter rules: %w", err)
+	}
+	for id, filter := range filters {
+		if slices.Contains(filter.Categories, categoryId) {
+			members = append(members, categoryMember{Type: categoryMemberFilter, Id: id, Description: filter.Description})
+		}
+	}
+
+	nats, err := client.Firewall().ListNATs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall NAT rules: %w", err)
+	}
+	for id, nat := range nats {
+		if slices.Contains(nat.Categories, categoryId) {
+			members = append(members, categoryMember{Type: categoryMemberNAT, Id: id, Description: nat.Description})
+		}
+	}
+
+	portForwards, err := client.Firewall().ListNatPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall NAT port forwards: %w", err)
+	}
+	for id, portForward := range portForwards {
+		if slices.Contains(portForward.Categories, categoryId) {
+			members = append(members, categoryMember{Type: categoryMemberNATPortForward, Id: id, Description: portForward.Description})
+		}
+	}
+
+	oneToOnes, err := client.Firewall().ListNatOneToOnes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall 1:1 NAT rules: %w", err)
+	}
+	for id, oneToOne := range oneToOnes {
+		if slices.Contains(oneToOne.Categories, categoryId) {
+			members = append(members, categoryMember{Type: categoryMemberNATOneToOne, Id: id, Description: oneToOne.Description})
+		}
+	}
+
+	npts, err := client.Firewall().ListNPTs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall NPT rules: %w", err)
+	}
+	for id, npt := range npts {
+		if slices.Contains(npt.Categories, categoryId) {
+			members = append(members, categoryMember{Type: categoryMemberNPT, Id: id, Description: npt.Description})
+		}
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Type != members[j].Type {
+			return members[i].Type < members[j].Type
+		}
+		if members[i].Description != members[j].Description {
+			return members[i].Description < members[j].Description
+		}
+		return members[i].Id < members[j].Id
+	})
+
+	return members, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
@@ -65,7 +66,7 @@ func (r *categoryResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Convert TF schema OPNsense struct
-	resourceStruct, err := convertCategorySchemaToStruct(data)
+	resourceStruct, err := convertCategorySchemaToStruct(&data.categoryModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("Unable to parse firwall category, got error: %s", err))
@@ -124,7 +125,7 @@ func (r *categoryResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Convert OPNsense struct to TF schema
-	resourceModel, err := convertCategoryStructToSchema(resourceStruct)
+	categoryModel, err := convertCategoryStructToSchema(resourceStruct)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("Unable to read firewall category, got error: %s", err))
@@ -132,7 +133,13 @@ func (r *categoryResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// ID cannot be added by convert... func, have to add here
-	resourceModel.Id = data.Id
+	categoryModel.Id = data.Id
+
+	// Not stored in OPNsense, keep the configured value (false on import)
+	resourceModel := &categoryResourceModel{
+		categoryModel:         *categoryModel,
+		PreventDestroyIfInUse: types.BoolValue(data.PreventDestroyIfInUse.ValueBool()),
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
@@ -149,7 +156,7 @@ func (r *categoryResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Convert TF schema OPNsense struct
-	resourceStruct, err := convertCategorySchemaToStruct(data)
+	resourceStruct, err := convertCategorySchemaToStruct(&data.categoryModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("Unable to parse firewall category, got error: %s", err))
@@ -178,6 +185,28 @@ func (r *categoryResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Checked on apply rather than plan, so objects destroyed in the same
+	// apply (which are deleted first) don't block the destroy
+	if data.PreventDestroyIfInUse.ValueBool() {
+		members, err := listCategoryMembers(ctx, r.client, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read firewall category members, got error: %s", err))
+			return
+		}
+
+		if len(members) > 0 {
+			var list strings.Builder
+			for _, m := range members {
+				list.WriteString(fmt.Sprintf("\n  - %s", m))
+			}
+			resp.Diagnostics.AddError("Category In Use",
+				fmt.Sprintf("Firewall category %q is assigned to %d object(s) and prevent_destroy_if_in_use is set:%s\n\nRemove the category from these objects, or unset prevent_destroy_if_in_use.",
+					data.Name.ValueString(), len(members), list.String()))
+			return
+		}
+	}
+
 	err := r.client.Firewall().DeleteCategory(ctx, data.Id.ValueString())
 
 	if err != nil {
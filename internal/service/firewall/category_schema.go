@@ -12,8 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// categoryResourceModel describes the resource data model.
-type categoryResourceModel struct {
+// categoryModel describes the category data model, shared by the resource
+// and data source.
+type categoryModel struct {
 	Automatic types.Bool   `tfsdk:"auto"`
 	Name      types.String `tfsdk:"name"`
 	Color     types.String `tfsdk:"color"`
@@ -21,6 +22,13 @@ type categoryResourceModel struct {
 	Id types.String `tfsdk:"id"`
 }
 
+// categoryResourceModel describes the resource data model.
+type categoryResourceModel struct {
+	categoryModel
+
+	PreventDestroyIfInUse types.Bool `tfsdk:"prevent_destroy_if_in_use"`
+}
+
 func categoryResourceSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "To ease maintenance of larger rulesets, OPNsense includes categories for the firewall. Each rule can contain one or more categories.",
@@ -42,6 +50,12 @@ func categoryResourceSchema() schema.Schema {
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"prevent_destroy_if_in_use": schema.BoolAttribute{
+				MarkdownDescription: "If set, destroying this category fails while aliases, filter rules, NAT rules, port forwards, 1:1 NAT rules or NPT rules are still assigned to it, and the error lists them. Otherwise, the category is removed from those objects. Objects destroyed in the same apply are removed first, so they do not block the destroy. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "UUID of the resource.",
@@ -78,7 +92,7 @@ func categoryDataSourceSchema() dschema.Schema {
 	}
 }
 
-func convertCategorySchemaToStruct(d *categoryResourceModel) (*firewall.Category, error) {
+func convertCategorySchemaToStruct(d *categoryModel) (*firewall.Category, error) {
 	return &firewall.Category{
 		Automatic: tools.BoolToString(d.Automatic.ValueBool()),
 		Name:      d.Name.ValueString(),
@@ -86,8 +100,8 @@ func convertCategorySchemaToStruct(d *categoryResourceModel) (*firewall.Category
 	}, nil
 }
 
-func convertCategoryStructToSchema(d *firewall.Category) (*categoryResourceModel, error) {
-	return &categoryResourceModel{
+func convertCategoryStructToSchema(d *firewall.Category) (*categoryModel, error) {
+	return &categoryModel{
 		Automatic: types.BoolValue(tools.StringToBool(d.Automatic)),
 		Name:      types.StringValue(d.Name),
 		Color:     types.StringValue(d.Color),
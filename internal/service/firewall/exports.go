@@ -3,6 +3,7 @@ package firewall
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -10,21 +11,45 @@ import (
 func Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		newAliasResource,
+		newAliasBulkResource,
 		newCategoryResource,
 		newFilterResource,
 		newNATResource,
+		newNATSettingsResource,
 		newNATOneToOneResource,
 		newNATPortForwardResource,
+		newNPTResource,
+		newScheduleResource,
+		newSettingsResource,
 	}
 }
 
 func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newAliasDataSource,
+		newAliasExportDataSource,
 		newCategoryDataSource,
+		newCategoryMembershipDataSource,
 		newFilterDataSource,
+		newFilterAnalysisDataSource,
+		newFilterEvaluateDataSource,
+		newFilterLegacyDataSource,
+		newLogDataSource,
 		newNATDataSource,
+		newNATSettingsDataSource,
 		newNATOneToOneDataSource,
 		newNATPortForwardDataSource,
+		newNPTDataSource,
+		newRuleStatsDataSource,
+		newScheduleDataSource,
+		newSettingsDataSource,
+		newStatesDataSource,
+	}
+}
+
+func Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		newKillStatesAction,
+		newMigrateLegacyFilterAction,
 	}
 }
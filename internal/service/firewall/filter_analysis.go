@@ -0,0 +1,100 @@
+package firewall
+
+import (
+	"fmt"
+)
+
+const (
+	findingShadowed    = "shadowed"
+	findingRedundant   = "redundant"
+	findingOverlyBroad = "overly_broad"
+)
+
+// filterFinding is a problem found in a rule set by analyzeFilterRules.
+type filterFinding struct {
+	RuleId        string
+	Description   string
+	Type          string
+	RelatedRuleId string
+	Message       string
+}
+
+// analyzeFilterRules looks for rules that can never decide the fate of a
+// packet, and for pass rules that match any traffic. rules must be in
+// evaluation order (see sortEngineRules).
+//
+// A rule never decides if every packet it matches is either first matched by
+// an earlier quick rule, or (if the rule is not quick itself) also matched by
+// a later rule. Such a rule is reported as shadowed if the deciding rule has
+// a different action, and as redundant otherwise.
+func analyzeFilterRules(rules []engineRule) []filterFinding {
+	findings := []filterFinding{}
+
+	for i := range rules {
+		rule := &rules[i]
+
+		if decider := findDecidingRule(rules, i); decider != nil {
+			findingType := findingRedundant
+			message := fmt.Sprintf("Rule %q is redundant: every packet it matches is also matched by rule %q, which has the same action (%s).",
+				rule.Id, decider.Id, decider.Action)
+			if decider.Action != rule.Action {
+				findingType = findingShadowed
+				message = fmt.Sprintf("Rule %q can never match: every packet it matches is decided by rule %q instead, which has action %s instead of %s.",
+					rule.Id, decider.Id, decider.Action, rule.Action)
+			}
+
+			findings = append(findings, filterFinding{
+				RuleId:        rule.Id,
+				Description:   rule.Description,
+				Type:          findingType,
+				RelatedRuleId: decider.Id,
+				Message:       message,
+			})
+		}
+
+		if rule.Action == "pass" && rule.Protocol == "ANY" && rule.Schedule == "" &&
+			rule.Source.IsAny() && rule.Destination.IsAny() {
+			findings = append(findings, filterFinding{
+				RuleId:      rule.Id,
+				Description: rule.Description,
+				Type:        findingOverlyBroad,
+				Message:     fmt.Sprintf("Rule %q passes any protocol from any source to any destination.", rule.Id),
+			})
+		}
+	}
+
+	return findings
+}
+
+// findDecidingRule returns the rule that decides every packet matched by
+// rules[i], or nil if rules[i] decides at least some packets (or this cannot
+// be determined).
+func findDecidingRule(rules []engineRule, i int) *engineRule {
+	rule := &rules[i]
+
+	// An earlier quick rule matches first
+	for j := 0; j < i; j++ {
+		if rules[j].Quick && rules[j].Covers(rule) {
+			return &rules[j]
+		}
+	}
+
+	// A quick rule stops evaluation, so later rules can't override it
+	if rule.Quick {
+		return nil
+	}
+
+	// A later rule overrides a non-quick rule (last match wins), unless a
+	// quick rule in between stops evaluation first
+	var decider *engineRule
+	for j := i + 1; j < len(rules); j++ {
+		if rules[j].Covers(rule) {
+			decider = &rules[j]
+			if decider.Quick {
+				break
+			}
+		}
+	}
+
+	return decider
+}
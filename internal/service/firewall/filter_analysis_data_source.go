@@ -0,0 +1,86 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &filterAnalysisDataSource{}
+var _ datasource.DataSourceWithConfigure = &filterAnalysisDataSource{}
+
+func newFilterAnalysisDataSource() datasource.DataSource {
+	return &filterAnalysisDataSource{}
+}
+
+// filterAnalysisDataSource defines the data source implementation.
+type filterAnalysisDataSource struct {
+	client opnsense.Client
+}
+
+func (d *filterAnalysisDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_filter_analysis"
+}
+
+func (d *filterAnalysisDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = filterAnalysisDataSourceSchema()
+}
+
+func (d *filterAnalysisDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *filterAnalysisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *filterAnalysisDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get filter rules, aliases and interfaces from OPNsense API
+	allRules, err := loadFilterRules(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall filter rules, got error: %s", err))
+		return
+	}
+
+	// Only keep rules that apply on the requested interfaces
+	interfaces := tools.SetToStringSlice(data.Interfaces)
+	rules := []engineRule{}
+	for _, rule := range allRules {
+		if len(interfaces) == 0 || slices.ContainsFunc(interfaces, rule.AppliesOn) {
+			rules = append(rules, rule)
+		}
+	}
+
+	data.RuleCount = types.Int64Value(int64(len(rules)))
+	data.Findings = convertFilterFindingsToSchema(analyzeFilterRules(rules))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
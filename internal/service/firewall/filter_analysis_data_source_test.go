@@ -0,0 +1,77 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallFilterAnalysisDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallFilterAnalysisDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_filter_analysis.test", "rule_count"),
+					resource.TestCheckTypeSetElemAttrPair("data.opnsense_firewall_filter_analysis.test", "findings.*.rule_id", "opnsense_firewall_filter.shadowed", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.opnsense_firewall_filter_analysis.test", "findings.*.related_rule_id", "opnsense_firewall_filter.block", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.opnsense_firewall_filter_analysis.test", "findings.*", map[string]string{
+						"type":        "shadowed",
+						"description": "Testing analysis shadowed",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallFilterAnalysisDataSourceConfig() string {
+	return `
+resource "opnsense_firewall_filter" "block" {
+  sequence    = 1
+  description = "Testing analysis block"
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action      = "block"
+    protocol    = "TCP"
+    destination = {
+      net = "192.0.2.0/24"
+    }
+  }
+}
+
+resource "opnsense_firewall_filter" "shadowed" {
+  sequence    = 2
+  description = "Testing analysis shadowed"
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action      = "pass"
+    protocol    = "TCP"
+    destination = {
+      net  = "192.0.2.10"
+      port = "443"
+    }
+  }
+}
+
+data "opnsense_firewall_filter_analysis" "test" {
+  interfaces = ["lan"]
+
+  depends_on = [
+    opnsense_firewall_filter.block,
+    opnsense_firewall_filter.shadowed,
+  ]
+}
+`
+}
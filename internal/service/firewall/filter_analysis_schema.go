@@ -0,0 +1,105 @@
+package firewall
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type filterAnalysisDataSourceModel struct {
+	Interfaces types.Set   `tfsdk:"interfaces"`
+	RuleCount  types.Int64 `tfsdk:"rule_count"`
+	Findings   types.List  `tfsdk:"findings"`
+}
+
+type filterFindingModel struct {
+	RuleId        types.String `tfsdk:"rule_id"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	RelatedRuleId types.String `tfsdk:"related_rule_id"`
+	Message       types.String `tfsdk:"message"`
+}
+
+var filterFindingAttrTypes = map[string]attr.Type{
+	"rule_id":         types.StringType,
+	"description":     types.StringType,
+	"type":            types.StringType,
+	"related_rule_id": types.StringType,
+	"message":         types.StringType,
+}
+
+func filterAnalysisDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Filter analysis lints the enabled firewall filter rules, and reports rules that can never take effect and pass rules that match any traffic. Rules are evaluated offline in sequence order, the same way pf evaluates them. Host, network and port aliases and interface networks are expanded; rules that use anything that cannot be expanded (hostnames, URL tables, GeoIP, ...) are only reported as shadowed by rules that match any address.",
+
+		Attributes: map[string]schema.Attribute{
+			"interfaces": schema.SetAttribute{
+				MarkdownDescription: "Set of interfaces (e.g. `lan`) to analyze the rules of. Floating rules are always included. Analyzes the rules of all interfaces if omitted.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"rule_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of enabled rules that were analyzed.",
+				Computed:            true,
+			},
+			"findings": schema.ListNestedAttribute{
+				MarkdownDescription: "Problems found in the rule set, in rule evaluation order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the filter rule the finding is about.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the filter rule the finding is about.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the finding. One of `shadowed` (the rule never matches, as a rule with a different action decides all of its packets), `redundant` (the rule can be removed, as a rule with the same action decides all of its packets) or `overly_broad` (a pass rule for any protocol, source and destination).",
+							Computed:            true,
+						},
+						"related_rule_id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the filter rule that decides the packets of a `shadowed` or `redundant` rule. Empty for other findings.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Human readable explanation of the finding.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertFilterFindingsToSchema(findings []filterFinding) types.List {
+	var models []filterFindingModel
+	for _, f := range findings {
+		models = append(models, filterFindingModel{
+			RuleId:        types.StringValue(f.RuleId),
+			Description:   types.StringValue(f.Description),
+			Type:          types.StringValue(f.Type),
+			RelatedRuleId: types.StringValue(f.RelatedRuleId),
+			Message:       types.StringValue(f.Message),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: filterFindingAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(models) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: filterFindingAttrTypes},
+			models,
+		)
+	}
+	return v
+}
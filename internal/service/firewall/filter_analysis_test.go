@@ -0,0 +1,204 @@
+package firewall
+
+import (
+	"encoding/json"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+// filterFixture is a rule set and its expected analysis, loaded from
+// testdata/filter_analysis.
+type filterFixture struct {
+	Aliases    map[string]snapshotAlias `json:"aliases"`
+	Interfaces map[string][]string      `json:"interfaces"`
+	Rules      []filterFixtureRule      `json:"rules"`
+	Findings   []filterFixtureFinding   `json:"findings"`
+	Packets    []filterFixturePacket    `json:"packets"`
+}
+
+type filterFixtureRule struct {
+	Id              string   `json:"id"`
+	Sequence        int64    `json:"sequence"`
+	Disabled        bool     `json:"disabled"`
+	Interfaces      []string `json:"interfaces"`
+	InvertInterface bool     `json:"invert_interface"`
+	Quick           *bool    `json:"quick"`
+	Action          string   `json:"action"`
+	Direction       string   `json:"direction"`
+	IPProtocol      string   `json:"ip_protocol"`
+	Protocol        string   `json:"protocol"`
+	ICMPTypes       []string `json:"icmp_types"`
+	Source          string   `json:"source"`
+	SourceInvert    bool     `json:"source_invert"`
+	SourcePort      string   `json:"source_port"`
+	Destination     string   `json:"destination"`
+	DestInvert      bool     `json:"destination_invert"`
+	DestinationPort string   `json:"destination_port"`
+	Schedule        string   `json:"schedule"`
+}
+
+type filterFixtureFinding struct {
+	RuleId        string `json:"rule_id"`
+	Type          string `json:"type"`
+	RelatedRuleId string `json:"related_rule_id"`
+}
+
+// filterFixturePacket is a packet and its expected evaluation. Trace lists
+// the evaluated rules as `<rule_id>:<result>`.
+type filterFixturePacket struct {
+	Name            string   `json:"name"`
+	Interface       string   `json:"interface"`
+	Direction       string   `json:"direction"`
+	Protocol        string   `json:"protocol"`
+	Source          string   `json:"source"`
+	SourcePort      uint16   `json:"source_port"`
+	Destination     string   `json:"destination"`
+	DestinationPort uint16   `json:"destination_port"`
+	ICMPType        string   `json:"icmp_type"`
+	Action          string   `json:"action"`
+	RuleId          string   `json:"rule_id"`
+	Indeterminate   bool     `json:"indeterminate"`
+	Trace           []string `json:"trace"`
+}
+
+func (f *filterFixture) snapshot() *filterSnapshot {
+	snapshot := &filterSnapshot{
+		Aliases:    f.Aliases,
+		Interfaces: map[string][]netip.Prefix{},
+	}
+	for name, addresses := range f.Interfaces {
+		for _, a := range addresses {
+			snapshot.Interfaces[name] = append(snapshot.Interfaces[name], netip.MustParsePrefix(a))
+		}
+	}
+	return snapshot
+}
+
+// model builds a rule model with the same defaults as the filter resource.
+func (r *filterFixtureRule) model() *filterResourceModel {
+	or := func(value, fallback string) string {
+		if value == "" {
+			return fallback
+		}
+		return value
+	}
+
+	quick := true
+	if r.Quick != nil {
+		quick = *r.Quick
+	}
+
+	return &filterResourceModel{
+		Enabled:     types.BoolValue(!r.Disabled),
+		Sequence:    types.Int64Value(r.Sequence),
+		Description: types.StringValue(r.Id),
+		Interface: &filterInterfaceBlock{
+			Invert:    types.BoolValue(r.InvertInterface),
+			Interface: tools.StringSliceToSet(r.Interfaces),
+		},
+		Filter: &filterFilterBlock{
+			Quick:         types.BoolValue(quick),
+			Action:        types.StringValue(r.Action),
+			Direction:     types.StringValue(or(r.Direction, "in")),
+			IPProtocol:    types.StringValue(or(r.IPProtocol, "inet")),
+			Protocol:      types.StringValue(or(r.Protocol, "any")),
+			ICMPType:      tools.StringSliceToSet(r.ICMPTypes),
+			TCPFlags:      tools.StringSliceToSet(nil),
+			TCPFlagsOutOf: tools.StringSliceToSet(nil),
+			Schedule:      types.StringValue(r.Schedule),
+			Source: &firewallLocation{
+				Net:    types.StringValue(or(r.Source, "any")),
+				Port:   types.StringValue(r.SourcePort),
+				Invert: types.BoolValue(r.SourceInvert),
+			},
+			Destination: &firewallLocation{
+				Net:    types.StringValue(or(r.Destination, "any")),
+				Port:   types.StringValue(r.DestinationPort),
+				Invert: types.BoolValue(r.DestInvert),
+			},
+		},
+	}
+}
+
+func loadFilterFixture(t *testing.T, path string) ([]engineRule, *filterFixture) {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var fixture filterFixture
+	require.NoError(t, json.Unmarshal(content, &fixture))
+
+	snapshot := fixture.snapshot()
+	rules := []engineRule{}
+	for _, r := range fixture.Rules {
+		if rule, ok := newEngineRule(r.Id, r.model(), snapshot); ok {
+			rules = append(rules, rule)
+		}
+	}
+	sortEngineRules(rules)
+
+	return rules, &fixture
+}
+
+func TestAnalyzeFilterRules(t *testing.T) {
+	paths, err := filepath.Glob("testdata/filter_analysis/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".json"), func(t *testing.T) {
+			rules, fixture := loadFilterFixture(t, path)
+
+			var got []filterFixtureFinding
+			for _, f := range analyzeFilterRules(rules) {
+				require.NotEmpty(t, f.Message)
+				got = append(got, filterFixtureFinding{
+					RuleId:        f.RuleId,
+					Type:          f.Type,
+					RelatedRuleId: f.RelatedRuleId,
+				})
+			}
+
+			require.Equal(t, fixture.Findings, got)
+		})
+	}
+}
+
+func TestAddrSet(t *testing.T) {
+	lan, _ := parseAddrLiteral("192.168.1.0/24")
+	host, _ := parseAddrLiteral("192.168.1.20")
+	rng, _ := parseAddrLiteral("192.168.1.250-192.168.2.5")
+
+	require.True(t, host.SubsetOf(lan))
+	require.False(t, lan.SubsetOf(host))
+	require.False(t, rng.SubsetOf(lan))
+	require.True(t, rng.SubsetOf(lan.Union(newAddrSet(prefixRange(netip.MustParsePrefix("192.168.2.0/24"))))))
+
+	notLan := lan.Complement()
+	require.False(t, notLan.Contains(netip.MustParseAddr("192.168.1.1")))
+	require.True(t, notLan.Contains(netip.MustParseAddr("192.168.2.1")))
+	require.True(t, notLan.Contains(netip.MustParseAddr("fd00::1")))
+	require.Equal(t, lan, notLan.Complement())
+
+	require.True(t, fullAddrSet().IsFull())
+	require.True(t, addrSet{}.Complement().IsFull())
+	require.Empty(t, fullAddrSet().Complement().Ranges)
+	require.True(t, lan.Union(notLan).IsFull())
+}
+
+func TestPortSet(t *testing.T) {
+	web := newPortSet(portRange{80, 80}, portRange{443, 443}, portRange{81, 100})
+
+	require.Equal(t, []portRange{{80, 100}, {443, 443}}, web.Ranges)
+	require.True(t, newPortSet(portRange{90, 95}).SubsetOf(web))
+	require.False(t, newPortSet(portRange{90, 110}).SubsetOf(web))
+	require.True(t, web.Contains(443))
+	require.False(t, web.Contains(8443))
+	require.True(t, newPortSet(portRange{0, 1000}, portRange{1001, 65535}).IsFull())
+}
@@ -0,0 +1,697 @@
+package firewall
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+)
+
+// The filter engine evaluates a snapshot of filter rules offline, the same
+// way pf would evaluate them: in sequence order, with the last matching rule
+// winning unless an earlier matching rule is `quick`. Aliases and interface
+// networks are expanded from the snapshot; anything that cannot be expanded
+// (hostnames, URL tables, GeoIP, ...) is marked as unresolved.
+
+// maxAliasDepth limits how deeply nested aliases are expanded, which also
+// guards against alias loops.
+const maxAliasDepth = 16
+
+// filterSnapshot holds the aliases and interface addresses that filter rules
+// are expanded against.
+type filterSnapshot struct {
+	// Aliases by name
+	Aliases map[string]snapshotAlias
+	// Interface addresses (with prefix length) by identifier (e.g. `lan`)
+	Interfaces map[string][]netip.Prefix
+}
+
+type snapshotAlias struct {
+	Type    string
+	Content []string
+}
+
+// engineRule is a filter rule reduced to the fields that decide which
+// packets it matches.
+type engineRule struct {
+	Id          string
+	Description string
+	Sequence    int64
+
+	Quick  bool
+	Action string
+
+	Interfaces      []string
+	InvertInterface bool
+	Direction       string
+	IPProtocol      string
+	Protocol        string
+	ICMPTypes       []string
+	TCPFlags        []string
+	TCPFlagsOutOf   []string
+	Schedule        string
+
+	Source      engineEndpoint
+	Destination engineEndpoint
+}
+
+// engineEndpoint is the source or destination of a rule. Addresses already
+// has `invert` applied.
+type engineEndpoint struct {
+	Addresses addrSet
+	Ports     portSet
+
+	// UnresolvedAddresses and UnresolvedPorts list the values that could not
+	// be expanded. If they are not empty, Addresses/Ports are incomplete.
+	UnresolvedAddresses []string
+	UnresolvedPorts     []string
+}
+
+// newEngineRule builds an engineRule from a rule model as produced by
+// convertFilterStructToSchema. Disabled rules return false.
+func newEngineRule(id string, m *filterResourceModel, snapshot *filterSnapshot) (engineRule, bool) {
+	if !m.Enabled.ValueBool() || m.Filter == nil {
+		return engineRule{}, false
+	}
+
+	rule := engineRule{
+		Id:          id,
+		Description: m.Description.ValueString(),
+		Sequence:    m.Sequence.ValueInt64(),
+		Quick:       m.Filter.Quick.ValueBool(),
+		Action:      m.Filter.Action.ValueString(),
+		Direction:   m.Filter.Direction.ValueString(),
+		IPProtocol:  m.Filter.IPProtocol.ValueString(),
+		Protocol:    strings.ToUpper(m.Filter.Protocol.ValueString()),
+		ICMPTypes:   tools.SetToStringSlice(m.Filter.ICMPType),
+		TCPFlags:    tools.SetToStringSlice(m.Filter.TCPFlags),
+		Schedule:    m.Filter.Schedule.ValueString(),
+
+		TCPFlagsOutOf: tools.SetToStringSlice(m.Filter.TCPFlagsOutOf),
+	}
+
+	if m.Interface != nil {
+		rule.Interfaces = tools.SetToStringSlice(m.Interface.Interface)
+		rule.InvertInterface = m.Interface.Invert.ValueBool()
+	}
+
+	rule.Source = snapshot.endpoint(m.Filter.Source, rule.hasPorts())
+	rule.Destination = snapshot.endpoint(m.Filter.Destination, rule.hasPorts())
+
+	return rule, true
+}
+
+// sortEngineRules sorts rules into evaluation order.
+func sortEngineRules(rules []engineRule) {
+	slices.SortStableFunc(rules, func(a, b engineRule) int {
+		return cmp.Or(cmp.Compare(a.Sequence, b.Sequence), cmp.Compare(a.Id, b.Id))
+	})
+}
+
+// hasPorts returns true if the rule's protocol carries ports.
+func (r *engineRule) hasPorts() bool {
+	return r.Protocol == "TCP" || r.Protocol == "UDP" || r.Protocol == "TCP/UDP"
+}
+
+// Covers returns true if every packet matched by o is also matched by r. It
+// returns false whenever this cannot be decided.
+func (r *engineRule) Covers(o *engineRule) bool {
+	// A scheduled rule is not always active, so only covers rules that share
+	// its schedule.
+	if r.Schedule != "" && r.Schedule != o.Schedule {
+		return false
+	}
+
+	return r.coversInterfaces(o) &&
+		(r.Direction == "any" || r.Direction == o.Direction) &&
+		(r.IPProtocol == "inet46" || r.IPProtocol == o.IPProtocol) &&
+		r.coversProtocol(o) &&
+		(len(r.ICMPTypes) == 0 || (len(o.ICMPTypes) > 0 && isSubset(o.ICMPTypes, r.ICMPTypes))) &&
+		(len(r.TCPFlags) == 0 || slices.Equal(sorted(r.TCPFlags), sorted(o.TCPFlags))) &&
+		(len(r.TCPFlagsOutOf) == 0 || slices.Equal(sorted(r.TCPFlagsOutOf), sorted(o.TCPFlagsOutOf))) &&
+		r.Source.covers(&o.Source) &&
+		r.Destination.covers(&o.Destination)
+}
+
+func (r *engineRule) coversProtocol(o *engineRule) bool {
+	switch {
+	case r.Protocol == "ANY" || r.Protocol == o.Protocol:
+		return true
+	case r.Protocol == "TCP/UDP":
+		return o.Protocol == "TCP" || o.Protocol == "UDP"
+	}
+	return false
+}
+
+// coversInterfaces returns true if r applies on every interface o applies on.
+// A rule without interfaces is floating, and applies on every interface.
+func (r *engineRule) coversInterfaces(o *engineRule) bool {
+	rAll := len(r.Interfaces) == 0 && !r.InvertInterface
+	oAll := len(o.Interfaces) == 0 && !o.InvertInterface
+
+	switch {
+	case rAll:
+		return true
+	case oAll:
+		return false
+	case !r.InvertInterface && !o.InvertInterface:
+		return isSubset(o.Interfaces, r.Interfaces)
+	case r.InvertInterface && !o.InvertInterface:
+		// r applies everywhere except its interfaces
+		for _, i := range o.Interfaces {
+			if slices.Contains(r.Interfaces, i) {
+				return false
+			}
+		}
+		return true
+	case r.InvertInterface && o.InvertInterface:
+		return isSubset(r.Interfaces, o.Interfaces)
+	}
+	return false
+}
+
+// AppliesOn returns true if the rule applies on the given interface.
+func (r *engineRule) AppliesOn(iface string) bool {
+	if len(r.Interfaces) == 0 && !r.InvertInterface {
+		return true
+	}
+	return slices.Contains(r.Interfaces, iface) != r.InvertInterface
+}
+
+func (e *engineEndpoint) covers(o *engineEndpoint) bool {
+	// Wildcards cover anything, even values that could not be resolved
+	addressesCovered := e.Addresses.IsFull()
+	portsCovered := e.Ports.IsFull()
+
+	if len(e.UnresolvedAddresses) == 0 && len(o.UnresolvedAddresses) == 0 {
+		addressesCovered = addressesCovered || o.Addresses.SubsetOf(e.Addresses)
+	}
+	if len(e.UnresolvedPorts) == 0 && len(o.UnresolvedPorts) == 0 {
+		portsCovered = portsCovered || o.Ports.SubsetOf(e.Ports)
+	}
+
+	return addressesCovered && portsCovered
+}
+
+// IsAny returns true if the endpoint matches any address and port.
+func (e *engineEndpoint) IsAny() bool {
+	return e.Addresses.IsFull() && e.Ports.IsFull()
+}
+
+// enginePacket describes a packet to evaluate rules against. Ports are 0 and
+// ICMPType is "" if not known.
+type enginePacket struct {
+	Interface       string
+	Direction       string
+	Protocol        string
+	Source          netip.Addr
+	SourcePort      uint16
+	Destination     netip.Addr
+	DestinationPort uint16
+	ICMPType        string
+}
+
+type matchResult string
+
+const (
+	matchYes     matchResult = "match"
+	matchNo      matchResult = "no_match"
+	matchUnknown matchResult = "unknown"
+)
+
+// Match returns whether the rule matches the packet, and the reason if it
+// does not or if this cannot be determined.
+func (r *engineRule) Match(p *enginePacket) (matchResult, string) {
+	if !r.AppliesOn(p.Interface) {
+		return matchNo, fmt.Sprintf("rule does not apply on interface %s", p.Interface)
+	}
+	if r.Direction != "any" && r.Direction != p.Direction {
+		return matchNo, fmt.Sprintf("rule only matches %sbound packets", r.Direction)
+	}
+
+	family := "inet"
+	if p.Source.Unmap().Is6() {
+		family = "inet6"
+	}
+	if r.IPProtocol != "inet46" && r.IPProtocol != family {
+		return matchNo, fmt.Sprintf("rule only matches %s packets", r.IPProtocol)
+	}
+
+	protocol := strings.ToUpper(p.Protocol)
+	if r.Protocol != "ANY" && r.Protocol != protocol &&
+		!(r.Protocol == "TCP/UDP" && (protocol == "TCP" || protocol == "UDP")) {
+		return matchNo, fmt.Sprintf("rule only matches %s packets", r.Protocol)
+	}
+
+	var unknown []string
+	for _, e := range []struct {
+		name     string
+		endpoint *engineEndpoint
+		addr     netip.Addr
+		port     uint16
+	}{
+		{"source", &r.Source, p.Source, p.SourcePort},
+		{"destination", &r.Destination, p.Destination, p.DestinationPort},
+	} {
+		result, reason := e.endpoint.match(e.name, e.addr, e.port)
+		switch result {
+		case matchNo:
+			return matchNo, reason
+		case matchUnknown:
+			unknown = append(unknown, reason)
+		}
+	}
+
+	if len(r.ICMPTypes) > 0 && (protocol == "ICMP" || protocol == "IPV6-ICMP") {
+		switch {
+		case p.ICMPType == "":
+			unknown = append(unknown, fmt.Sprintf("rule only matches ICMP types %s, but the packet's ICMP type is not set", strings.Join(r.ICMPTypes, ", ")))
+		case !slices.Contains(r.ICMPTypes, p.ICMPType):
+			return matchNo, fmt.Sprintf("rule only matches ICMP types %s", strings.Join(r.ICMPTypes, ", "))
+		}
+	}
+	if len(r.TCPFlags) > 0 && protocol == "TCP" {
+		unknown = append(unknown, fmt.Sprintf("rule only matches TCP flags %s", strings.Join(r.TCPFlags, ", ")))
+	}
+	if r.Schedule != "" {
+		unknown = append(unknown, fmt.Sprintf("rule is only active during schedule %s", r.Schedule))
+	}
+
+	if len(unknown) > 0 {
+		return matchUnknown, strings.Join(unknown, "; ")
+	}
+	return matchYes, ""
+}
+
+func (e *engineEndpoint) match(name string, addr netip.Addr, port uint16) (matchResult, string) {
+	result, reason := matchYes, ""
+
+	if !e.Addresses.Contains(addr) {
+		if len(e.UnresolvedAddresses) == 0 {
+			return matchNo, fmt.Sprintf("%s address %s does not match", name, addr)
+		}
+		result, reason = matchUnknown, fmt.Sprintf("%s %s could not be expanded", name, strings.Join(e.UnresolvedAddresses, ", "))
+	}
+
+	if !e.Ports.IsFull() {
+		switch {
+		case port == 0:
+			return matchUnknown, fmt.Sprintf("rule matches specific %s ports, but the packet's %s port is not set", name, name)
+		case e.Ports.Contains(port):
+		case len(e.UnresolvedPorts) == 0:
+			return matchNo, fmt.Sprintf("%s port %d does not match", name, port)
+		default:
+			return matchUnknown, fmt.Sprintf("%s port %s could not be expanded", name, strings.Join(e.UnresolvedPorts, ", "))
+		}
+	}
+
+	return result, reason
+}
+
+func (s *filterSnapshot) endpoint(l *firewallLocation, hasPorts bool) engineEndpoint {
+	endpoint := engineEndpoint{
+		Addresses: fullAddrSet(),
+		Ports:     fullPortSet(),
+	}
+	if l == nil {
+		return endpoint
+	}
+
+	addresses, unresolved := s.resolveNet(l.Net.ValueString(), 0)
+	endpoint.UnresolvedAddresses = unresolved
+	if l.Invert.ValueBool() {
+		// The complement of a partially resolved set would include addresses
+		// that are in fact excluded, so leave it empty instead.
+		if len(unresolved) > 0 {
+			addresses = addrSet{}
+		} else {
+			addresses = addresses.Complement()
+		}
+	}
+	endpoint.Addresses = addresses
+
+	// Ports are ignored by protocols that do not carry them
+	if hasPorts {
+		endpoint.Ports, endpoint.UnresolvedPorts = s.resolvePort(l.Port.ValueString(), 0)
+	}
+
+	return endpoint
+}
+
+// resolveNet expands a source/destination network to a set of addresses,
+// returning the values that could not be expanded.
+func (s *filterSnapshot) resolveNet(value string, depth int) (addrSet, []string) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "any" {
+		return fullAddrSet(), nil
+	}
+	if depth > maxAliasDepth {
+		return addrSet{}, []string{value}
+	}
+
+	// Comma separated lists
+	if strings.Contains(value, ",") {
+		var result addrSet
+		var unresolved []string
+		for _, v := range strings.Split(value, ",") {
+			set, u := s.resolveNet(v, depth)
+			result = result.Union(set)
+			unresolved = append(unresolved, u...)
+		}
+		return result, unresolved
+	}
+
+	if set, ok := parseAddrLiteral(value); ok {
+		return set, nil
+	}
+
+	if alias, ok := s.Aliases[value]; ok {
+		switch alias.Type {
+		case "host", "network", "networkgroup":
+			var result addrSet
+			var unresolved []string
+			for _, c := range alias.Content {
+				if c == "" {
+					continue
+				}
+				set, u := s.resolveNet(c, depth+1)
+				result = result.Union(set)
+				unresolved = append(unresolved, u...)
+			}
+			return result, unresolved
+		}
+		return addrSet{}, []string{value}
+	}
+
+	// Interface macros: `(self)`, `<if>`, `<if>ip`, `__<if>_network`, `__<if>_address`
+	if value == "(self)" {
+		var result addrSet
+		for _, prefixes := range s.Interfaces {
+			for _, p := range prefixes {
+				result = result.Union(newAddrSet(addrRange{p.Addr(), p.Addr()}))
+			}
+		}
+		return result, nil
+	}
+	if m := interfaceMacroRegex.FindStringSubmatch(value); m != nil {
+		if prefixes, ok := s.Interfaces[m[1]]; ok {
+			return interfaceAddrSet(prefixes, m[2] == "network"), nil
+		}
+	}
+	if prefixes, ok := s.Interfaces[value]; ok {
+		return interfaceAddrSet(prefixes, true), nil
+	}
+	if m := interfaceSuffixRegex.FindStringSubmatch(value); m != nil {
+		if prefixes, ok := s.Interfaces[m[1]]; ok {
+			return interfaceAddrSet(prefixes, false), nil
+		}
+	}
+
+	return addrSet{}, []string{value}
+}
+
+// resolvePort expands a port field to a set of ports, returning the values
+// that could not be expanded.
+func (s *filterSnapshot) resolvePort(value string, depth int) (portSet, []string) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "any" {
+		return fullPortSet(), nil
+	}
+	if depth > maxAliasDepth {
+		return portSet{}, []string{value}
+	}
+
+	if port, ok := wellKnownPorts[value]; ok {
+		return newPortSet(portRange{port, port}), nil
+	}
+
+	// Rules use `from-to` for port ranges, port aliases use `from:to`
+	from, to, found := strings.Cut(value, "-")
+	if !found {
+		from, to, found = strings.Cut(value, ":")
+	}
+	if !found {
+		to = from
+	}
+	lo, loErr := strconv.ParseUint(from, 10, 16)
+	hi, hiErr := strconv.ParseUint(to, 10, 16)
+	if loErr == nil && hiErr == nil {
+		return newPortSet(portRange{uint16(min(lo, hi)), uint16(max(lo, hi))}), nil
+	}
+
+	if alias, ok := s.Aliases[value]; ok && alias.Type == "port" {
+		var result portSet
+		var unresolved []string
+		for _, c := range alias.Content {
+			if c == "" {
+				continue
+			}
+			set, u := s.resolvePort(c, depth+1)
+			result = result.Union(set)
+			unresolved = append(unresolved, u...)
+		}
+		return result, unresolved
+	}
+
+	return portSet{}, []string{value}
+}
+
+// parseAddrLiteral parses an IP address, CIDR or `from-to` IP range.
+func parseAddrLiteral(value string) (addrSet, bool) {
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return newAddrSet(addrRange{addr, addr}), true
+	}
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		return newAddrSet(prefixRange(prefix)), true
+	}
+	if from, to, found := strings.Cut(value, "-"); found {
+		lo, loErr := netip.ParseAddr(strings.TrimSpace(from))
+		hi, hiErr := netip.ParseAddr(strings.TrimSpace(to))
+		if loErr == nil && hiErr == nil && lo.BitLen() == hi.BitLen() {
+			if hi.Less(lo) {
+				lo, hi = hi, lo
+			}
+			return newAddrSet(addrRange{lo, hi}), true
+		}
+	}
+	return addrSet{}, false
+}
+
+func interfaceAddrSet(prefixes []netip.Prefix, network bool) addrSet {
+	var result addrSet
+	for _, p := range prefixes {
+		if network {
+			result = result.Union(newAddrSet(prefixRange(p)))
+		} else {
+			result = result.Union(newAddrSet(addrRange{p.Addr(), p.Addr()}))
+		}
+	}
+	return result
+}
+
+// addrRange is an inclusive range of addresses of the same family.
+type addrRange struct {
+	From netip.Addr
+	To   netip.Addr
+}
+
+func (r addrRange) String() string {
+	if r.From == r.To {
+		return r.From.String()
+	}
+	return fmt.Sprintf("%s-%s", r.From, r.To)
+}
+
+func prefixRange(p netip.Prefix) addrRange {
+	p = p.Masked()
+	last := p.Addr().AsSlice()
+	for bit := p.Bits(); bit < p.Addr().BitLen(); bit++ {
+		last[bit/8] |= 1 << (7 - bit%8)
+	}
+	to, _ := netip.AddrFromSlice(last)
+	return addrRange{p.Addr(), to}
+}
+
+var (
+	ipv4Range = addrRange{netip.IPv4Unspecified(), netip.AddrFrom4([4]byte{255, 255, 255, 255})}
+	ipv6Range = addrRange{netip.IPv6Unspecified(), netip.AddrFrom16([16]byte{
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+	})}
+)
+
+// addrSet is a set of IPv4 and IPv6 addresses, stored as sorted,
+// non-overlapping, non-adjacent ranges.
+type addrSet struct {
+	Ranges []addrRange
+}
+
+func newAddrSet(ranges ...addrRange) addrSet {
+	ranges = slices.Clone(ranges)
+	slices.SortFunc(ranges, func(a, b addrRange) int { return a.From.Compare(b.From) })
+
+	var merged []addrRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			next := last.To.Next()
+			if last.To.BitLen() == r.From.BitLen() && (!next.IsValid() || !next.Less(r.From)) {
+				if last.To.Less(r.To) {
+					last.To = r.To
+				}
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return addrSet{Ranges: merged}
+}
+
+func fullAddrSet() addrSet {
+	return newAddrSet(ipv4Range, ipv6Range)
+}
+
+func (s addrSet) Union(o addrSet) addrSet {
+	return newAddrSet(append(slices.Clone(s.Ranges), o.Ranges...)...)
+}
+
+func (s addrSet) IsFull() bool {
+	return len(s.Ranges) == 2 && s.Ranges[0] == ipv4Range && s.Ranges[1] == ipv6Range
+}
+
+func (s addrSet) Contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, r := range s.Ranges {
+		if !addr.Less(r.From) && !r.To.Less(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// SubsetOf returns true if every address in s is also in o.
+func (s addrSet) SubsetOf(o addrSet) bool {
+	for _, r := range s.Ranges {
+		covered := false
+		for _, or := range o.Ranges {
+			// o is merged, so r must fit into a single range of o
+			if r.From.BitLen() == or.From.BitLen() && !r.From.Less(or.From) && !or.To.Less(r.To) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// Complement returns every address not in s.
+func (s addrSet) Complement() addrSet {
+	var result []addrRange
+	for _, family := range []addrRange{ipv4Range, ipv6Range} {
+		next := family.From
+		exhausted := false
+		for _, r := range s.Ranges {
+			if r.From.BitLen() != family.From.BitLen() {
+				continue
+			}
+			if next.Less(r.From) {
+				result = append(result, addrRange{next, r.From.Prev()})
+			}
+			if r.To == family.To {
+				exhausted = true
+				break
+			}
+			next = r.To.Next()
+		}
+		if !exhausted {
+			result = append(result, addrRange{next, family.To})
+		}
+	}
+	return newAddrSet(result...)
+}
+
+// portRange is an inclusive range of ports.
+type portRange struct {
+	From uint16
+	To   uint16
+}
+
+// portSet is a set of ports, stored as sorted, non-overlapping,
+// non-adjacent ranges.
+type portSet struct {
+	Ranges []portRange
+}
+
+func newPortSet(ranges ...portRange) portSet {
+	ranges = slices.Clone(ranges)
+	slices.SortFunc(ranges, func(a, b portRange) int { return cmp.Compare(a.From, b.From) })
+
+	var merged []portRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && int(merged[n-1].To)+1 >= int(r.From) {
+			merged[n-1].To = max(merged[n-1].To, r.To)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return portSet{Ranges: merged}
+}
+
+func fullPortSet() portSet {
+	return newPortSet(portRange{0, 65535})
+}
+
+func (s portSet) Union(o portSet) portSet {
+	return newPortSet(append(slices.Clone(s.Ranges), o.Ranges...)...)
+}
+
+func (s portSet) IsFull() bool {
+	return len(s.Ranges) == 1 && s.Ranges[0] == portRange{0, 65535}
+}
+
+func (s portSet) Contains(port uint16) bool {
+	for _, r := range s.Ranges {
+		if port >= r.From && port <= r.To {
+			return true
+		}
+	}
+	return false
+}
+
+// SubsetOf returns true if every port in s is also in o.
+func (s portSet) SubsetOf(o portSet) bool {
+	for _, r := range s.Ranges {
+		covered := false
+		for _, or := range o.Ranges {
+			if r.From >= or.From && r.To <= or.To {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func isSubset(s, o []string) bool {
+	for _, v := range s {
+		if !slices.Contains(o, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func sorted(s []string) []string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+	return s
+}
@@ -0,0 +1,75 @@
+package firewall
+
+// filterEvaluation is the outcome of evaluating a packet against a rule set.
+type filterEvaluation struct {
+	// Action taken for the packet, and the rule that decided it. RuleId is
+	// empty if no rule matched and the default action applies.
+	Action      string
+	RuleId      string
+	Description string
+
+	// Indeterminate is true if a rule may or may not match the packet, so the
+	// outcome may differ on the firewall.
+	Indeterminate bool
+
+	Trace []filterEvaluationStep
+}
+
+// filterEvaluationStep records the result of evaluating a single rule.
+type filterEvaluationStep struct {
+	RuleId      string
+	Description string
+	Action      string
+	Quick       bool
+	Result      matchResult
+	Reason      string
+}
+
+// evaluateFilterRules evaluates a packet against rules the same way pf does:
+// the last matching rule decides, unless a matching rule is quick. Rules that
+// may or may not match are treated as not matching, and mark the evaluation
+// as indeterminate. If no rule matches, inbound packets are blocked and
+// outbound packets are passed, as by the OPNsense default rules. rules must
+// be in evaluation order (see sortEngineRules).
+func evaluateFilterRules(rules []engineRule, p *enginePacket) filterEvaluation {
+	evaluation := filterEvaluation{
+		Action: "block",
+		Trace:  []filterEvaluationStep{},
+	}
+	if p.Direction == "out" {
+		evaluation.Action = "pass"
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+
+		// Only trace rules on the packet's interface
+		if !rule.AppliesOn(p.Interface) {
+			continue
+		}
+
+		result, reason := rule.Match(p)
+		evaluation.Trace = append(evaluation.Trace, filterEvaluationStep{
+			RuleId:      rule.Id,
+			Description: rule.Description,
+			Action:      rule.Action,
+			Quick:       rule.Quick,
+			Result:      result,
+			Reason:      reason,
+		})
+
+		switch result {
+		case matchUnknown:
+			evaluation.Indeterminate = true
+		case matchYes:
+			evaluation.Action = rule.Action
+			evaluation.RuleId = rule.Id
+			evaluation.Description = rule.Description
+			if rule.Quick {
+				return evaluation
+			}
+		}
+	}
+
+	return evaluation
+}
@@ -0,0 +1,131 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &filterEvaluateDataSource{}
+var _ datasource.DataSourceWithConfigure = &filterEvaluateDataSource{}
+
+func newFilterEvaluateDataSource() datasource.DataSource {
+	return &filterEvaluateDataSource{}
+}
+
+// filterEvaluateDataSource defines the data source implementation.
+type filterEvaluateDataSource struct {
+	client opnsense.Client
+}
+
+func (d *filterEvaluateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_filter_evaluate"
+}
+
+func (d *filterEvaluateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = filterEvaluateDataSourceSchema()
+}
+
+func (d *filterEvaluateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *filterEvaluateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *filterEvaluateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Addresses are validated by the schema
+	source, _ := netip.ParseAddr(data.SourceAddress.ValueString())
+	destination, _ := netip.ParseAddr(data.DestinationAddress.ValueString())
+	if source.Unmap().Is4() != destination.Unmap().Is4() {
+		resp.Diagnostics.AddAttributeError(path.Root("destination_address"), "Invalid Attribute Value",
+			"destination_address must be of the same address family as source_address")
+		return
+	}
+
+	packet := &enginePacket{
+		Interface:       data.Interface.ValueString(),
+		Direction:       "in",
+		Protocol:        data.Protocol.ValueString(),
+		Source:          source,
+		SourcePort:      uint16(data.SourcePort.ValueInt64()),
+		Destination:     destination,
+		DestinationPort: uint16(data.DestinationPort.ValueInt64()),
+		ICMPType:        data.ICMPType.ValueString(),
+	}
+	if !data.Direction.IsNull() {
+		packet.Direction = data.Direction.ValueString()
+	}
+
+	// Get aliases and interfaces from OPNsense API
+	snapshot, err := loadFilterSnapshot(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall filter rules, got error: %s", err))
+		return
+	}
+
+	// Aliases given as input replace the ones of the same name
+	var aliases map[string]filterEvaluateAliasModel
+	resp.Diagnostics.Append(data.Aliases.ElementsAs(ctx, &aliases, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for name, alias := range aliases {
+		snapshot.Aliases[name] = snapshotAlias{
+			Type:    alias.Type.ValueString(),
+			Content: tools.SetToStringSlice(alias.Content),
+		}
+	}
+
+	// Evaluate the rules given as input, or else the rules on OPNsense
+	var rules []engineRule
+	if data.Rules.IsNull() {
+		rules, err = loadFilterRulesWithSnapshot(ctx, d.client, snapshot)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read firewall filter rules, got error: %s", err))
+			return
+		}
+	} else {
+		var inputs []filterEvaluateRuleModel
+		resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &inputs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rules = newEngineRulesFromInput(inputs, snapshot)
+	}
+
+	evaluation := evaluateFilterRules(rules, packet)
+	convertFilterEvaluationToSchema(&evaluation, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
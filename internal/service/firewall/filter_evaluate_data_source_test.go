@@ -0,0 +1,75 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallFilterEvaluateDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallFilterEvaluateDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opnsense_firewall_filter_evaluate.test", "action", "reject"),
+					resource.TestCheckResourceAttrPair("data.opnsense_firewall_filter_evaluate.test", "rule_id", "opnsense_firewall_filter.test", "id"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_filter_evaluate.test", "description", "Testing evaluate"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.opnsense_firewall_filter_evaluate.test", "trace.*", map[string]string{
+						"description": "Testing evaluate",
+						"result":      "match",
+					}),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_filter_evaluate.test_rules", "action", "reject"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_filter_evaluate.test_rules", "rule_id", "rules[0]"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_filter_evaluate.test_rules", "description", "Testing evaluate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallFilterEvaluateDataSourceConfig() string {
+	return `
+resource "opnsense_firewall_filter" "test" {
+  sequence    = 1
+  description = "Testing evaluate"
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action      = "reject"
+    direction   = "in"
+    protocol    = "TCP"
+    destination = {
+      net  = "192.0.2.10"
+      port = "443"
+    }
+  }
+}
+
+data "opnsense_firewall_filter_evaluate" "test" {
+  interface           = "lan"
+  protocol            = "TCP"
+  source_address      = "10.1.2.3"
+  destination_address = "192.0.2.10"
+  destination_port    = 443
+
+  depends_on = [opnsense_firewall_filter.test]
+}
+
+data "opnsense_firewall_filter_evaluate" "test_rules" {
+  interface           = "lan"
+  protocol            = "TCP"
+  source_address      = "10.1.2.3"
+  destination_address = "192.0.2.10"
+  destination_port    = 443
+
+  rules = [opnsense_firewall_filter.test]
+}
+`
+}
@@ -0,0 +1,418 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type filterEvaluateDataSourceModel struct {
+	Interface          types.String `tfsdk:"interface"`
+	Direction          types.String `tfsdk:"direction"`
+	Protocol           types.String `tfsdk:"protocol"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	SourcePort         types.Int64  `tfsdk:"source_port"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	DestinationPort    types.Int64  `tfsdk:"destination_port"`
+	ICMPType           types.String `tfsdk:"icmp_type"`
+	Rules              types.List   `tfsdk:"rules"`
+	Aliases            types.Map    `tfsdk:"aliases"`
+
+	Action        types.String `tfsdk:"action"`
+	RuleId        types.String `tfsdk:"rule_id"`
+	Description   types.String `tfsdk:"description"`
+	Indeterminate types.Bool   `tfsdk:"indeterminate"`
+	Trace         types.List   `tfsdk:"trace"`
+}
+
+// filterEvaluateRuleModel is a filter rule given as input, with the
+// attributes of the filter rule resource that decide which packets it matches.
+type filterEvaluateRuleModel struct {
+	Enabled     types.Bool                 `tfsdk:"enabled"`
+	Sequence    types.Int64                `tfsdk:"sequence"`
+	Description types.String               `tfsdk:"description"`
+	Interface   *filterInterfaceBlock      `tfsdk:"interface"`
+	Filter      *filterEvaluateFilterBlock `tfsdk:"filter"`
+}
+
+type filterEvaluateFilterBlock struct {
+	Quick         types.Bool        `tfsdk:"quick"`
+	Action        types.String      `tfsdk:"action"`
+	Direction     types.String      `tfsdk:"direction"`
+	IPProtocol    types.String      `tfsdk:"ip_protocol"`
+	Protocol      types.String      `tfsdk:"protocol"`
+	ICMPType      types.Set         `tfsdk:"icmp_type"`
+	Source        *firewallLocation `tfsdk:"source"`
+	Destination   *firewallLocation `tfsdk:"destination"`
+	TCPFlags      types.Set         `tfsdk:"tcp_flags"`
+	TCPFlagsOutOf types.Set         `tfsdk:"tcp_flags_out_of"`
+	Schedule      types.String      `tfsdk:"schedule"`
+}
+
+// filterEvaluateAliasModel is an alias given as input, with the attributes
+// of the alias resource that rules are expanded against.
+type filterEvaluateAliasModel struct {
+	Type    types.String `tfsdk:"type"`
+	Content types.Set    `tfsdk:"content"`
+}
+
+type filterEvaluationStepModel struct {
+	RuleId      types.String `tfsdk:"rule_id"`
+	Description types.String `tfsdk:"description"`
+	Action      types.String `tfsdk:"action"`
+	Quick       types.Bool   `tfsdk:"quick"`
+	Result      types.String `tfsdk:"result"`
+	Reason      types.String `tfsdk:"reason"`
+}
+
+var filterEvaluationStepAttrTypes = map[string]attr.Type{
+	"rule_id":     types.StringType,
+	"description": types.StringType,
+	"action":      types.StringType,
+	"quick":       types.BoolType,
+	"result":      types.StringType,
+	"reason":      types.StringType,
+}
+
+func filterEvaluateDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Filter evaluate simulates how the enabled firewall filter rules handle a packet, and returns the rule that decides it. Rules are evaluated offline in sequence order, the same way pf evaluates them: the last matching rule decides, unless a matching rule is quick. If no rule matches, inbound packets are blocked and outbound packets are passed, as by the OPNsense default rules. Host, network and port aliases and interface networks are expanded; rules that use anything that cannot be expanded (hostnames, URL tables, GeoIP, ...) or depend on something the packet does not describe (schedules, TCP flags, ...) are treated as not matching and set `indeterminate`.\n\nBy default the rules are read from OPNsense, so the result reflects the rules that are already applied. To evaluate planned rules instead, pass them in `rules` (e.g. `rules = values(opnsense_firewall_filter.this)`), and any aliases they use that are not applied yet in `aliases`.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface the packet passes (e.g. `lan`).",
+				Required:            true,
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "Direction of the packet on `interface`. One of `in` or `out`. Defaults to `in`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("in", "out"),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol of the packet (e.g. `TCP`, `UDP`, `ICMP`).",
+				Required:            true,
+			},
+			"source_address": schema.StringAttribute{
+				MarkdownDescription: "Source IP address of the packet.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"source_port": schema.Int64Attribute{
+				MarkdownDescription: "Source port of the packet. Rules that match specific source ports set `indeterminate` if omitted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"destination_address": schema.StringAttribute{
+				MarkdownDescription: "Destination IP address of the packet. Must be of the same address family as `source_address`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"destination_port": schema.Int64Attribute{
+				MarkdownDescription: "Destination port of the packet. Rules that match specific destination ports set `indeterminate` if omitted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"icmp_type": schema.StringAttribute{
+				MarkdownDescription: "ICMP type of the packet (e.g. `echoreq`). Rules that match specific ICMP types set `indeterminate` if omitted.",
+				Optional:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Filter rules to evaluate instead of the rules applied on OPNsense. Takes the same attributes as `opnsense_firewall_filter`, so resources can be passed as is; attributes that do not decide which packets a rule matches are ignored. Rules given here are identified by their index (e.g. `rules[0]`) in `rule_id`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the filter rule is enabled. Defaults to `true`.",
+							Optional:            true,
+						},
+						"sequence": schema.Int64Attribute{
+							MarkdownDescription: "Order of the filter rule. Defaults to `1`.",
+							Optional:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the filter rule.",
+							Optional:            true,
+						},
+						"interface": schema.SingleNestedAttribute{
+							Required: true,
+							Attributes: map[string]schema.Attribute{
+								"invert": schema.BoolAttribute{
+									MarkdownDescription: "Whether the rule applies on all but the selected interfaces. Defaults to `false`.",
+									Optional:            true,
+								},
+								"interface": schema.SetAttribute{
+									MarkdownDescription: "The interfaces the filter rule applies on. Leave empty for a floating rule.",
+									Optional:            true,
+									ElementType:         types.StringType,
+								},
+							},
+						},
+						"filter": schema.SingleNestedAttribute{
+							Required: true,
+							Attributes: map[string]schema.Attribute{
+								"quick": schema.BoolAttribute{
+									MarkdownDescription: "Whether a match stops evaluation. Defaults to `true`.",
+									Optional:            true,
+								},
+								"action": schema.StringAttribute{
+									MarkdownDescription: "Action of the filter rule. One of `pass`, `block` or `reject`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("pass", "block", "reject"),
+									},
+								},
+								"direction": schema.StringAttribute{
+									MarkdownDescription: "Direction of the traffic. One of `in`, `out` or `any`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("in", "out", "any"),
+									},
+								},
+								"ip_protocol": schema.StringAttribute{
+									MarkdownDescription: "Address family. One of `inet`, `inet6` or `inet46`. Defaults to `inet`.",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("inet", "inet6", "inet46"),
+									},
+								},
+								"protocol": schema.StringAttribute{
+									MarkdownDescription: "Protocol of the traffic (e.g. `TCP`, `any`).",
+									Required:            true,
+								},
+								"icmp_type": schema.SetAttribute{
+									MarkdownDescription: "ICMP types the filter rule matches. Leave empty for any.",
+									Optional:            true,
+									ElementType:         types.StringType,
+								},
+								"source": schema.SingleNestedAttribute{
+									MarkdownDescription: "Source of the traffic. Defaults to any.",
+									Optional:            true,
+									Attributes: map[string]schema.Attribute{
+										"net": schema.StringAttribute{
+											MarkdownDescription: "IP address, CIDR, alias or interface network (e.g. `lan`). Defaults to `any`.",
+											Optional:            true,
+										},
+										"port": schema.StringAttribute{
+											MarkdownDescription: "Port, port range or port alias. Defaults to any port.",
+											Optional:            true,
+										},
+										"invert": schema.BoolAttribute{
+											MarkdownDescription: "Whether to match everything but `net`. Defaults to `false`.",
+											Optional:            true,
+										},
+									},
+								},
+								"destination": schema.SingleNestedAttribute{
+									MarkdownDescription: "Destination of the traffic. Defaults to any.",
+									Optional:            true,
+									Attributes: map[string]schema.Attribute{
+										"net": schema.StringAttribute{
+											MarkdownDescription: "IP address, CIDR, alias or interface network (e.g. `lan`). Defaults to `any`.",
+											Optional:            true,
+										},
+										"port": schema.StringAttribute{
+											MarkdownDescription: "Port, port range or port alias. Defaults to any port.",
+											Optional:            true,
+										},
+										"invert": schema.BoolAttribute{
+											MarkdownDescription: "Whether to match everything but `net`. Defaults to `false`.",
+											Optional:            true,
+										},
+									},
+								},
+								"tcp_flags": schema.SetAttribute{
+									MarkdownDescription: "TCP flags that must be set.",
+									Optional:            true,
+									ElementType:         types.StringType,
+								},
+								"tcp_flags_out_of": schema.SetAttribute{
+									MarkdownDescription: "TCP flags that are checked.",
+									Optional:            true,
+									ElementType:         types.StringType,
+								},
+								"schedule": schema.StringAttribute{
+									MarkdownDescription: "Schedule the filter rule is active in.",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"aliases": schema.MapNestedAttribute{
+				MarkdownDescription: "Aliases by name to expand rules against, in addition to the aliases on OPNsense. Takes the same attributes as `opnsense_firewall_alias`, so resources can be passed as is (e.g. `{ (opnsense_firewall_alias.web.name) = opnsense_firewall_alias.web }`). An alias given here replaces the OPNsense alias of the same name.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the alias (e.g. `host`, `network`, `port`).",
+							Required:            true,
+						},
+						"content": schema.SetAttribute{
+							MarkdownDescription: "Content of the alias.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Action taken for the packet. One of `pass`, `block` or `reject`.",
+				Computed:            true,
+			},
+			"rule_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the filter rule that decides the packet, or its index for rules given in `rules` (e.g. `rules[0]`). Empty if no rule matches and the default action applies.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the filter rule that decides the packet.",
+				Computed:            true,
+			},
+			"indeterminate": schema.BoolAttribute{
+				MarkdownDescription: "Whether a rule may or may not match the packet, so the outcome on the firewall may differ. See `trace` for details.",
+				Computed:            true,
+			},
+			"trace": schema.ListNestedAttribute{
+				MarkdownDescription: "Every rule on `interface` that was evaluated, in evaluation order, up to the deciding quick rule.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the filter rule, or its index for rules given in `rules`.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the filter rule.",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action of the filter rule.",
+							Computed:            true,
+						},
+						"quick": schema.BoolAttribute{
+							MarkdownDescription: "Whether the filter rule is quick, and stops evaluation if it matches.",
+							Computed:            true,
+						},
+						"result": schema.StringAttribute{
+							MarkdownDescription: "Whether the rule matches the packet. One of `match`, `no_match` or `unknown`.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "Why the rule does not match, or why this cannot be determined. Empty for `match`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertFilterEvaluationToSchema(d *filterEvaluation, model *filterEvaluateDataSourceModel) {
+	model.Action = types.StringValue(d.Action)
+	model.RuleId = types.StringValue(d.RuleId)
+	model.Description = types.StringValue(d.Description)
+	model.Indeterminate = types.BoolValue(d.Indeterminate)
+
+	var steps []filterEvaluationStepModel
+	for _, s := range d.Trace {
+		steps = append(steps, filterEvaluationStepModel{
+			RuleId:      types.StringValue(s.RuleId),
+			Description: types.StringValue(s.Description),
+			Action:      types.StringValue(s.Action),
+			Quick:       types.BoolValue(s.Quick),
+			Result:      types.StringValue(string(s.Result)),
+			Reason:      types.StringValue(s.Reason),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: filterEvaluationStepAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(steps) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: filterEvaluationStepAttrTypes},
+			steps,
+		)
+	}
+	model.Trace = v
+}
+
+// convertFilterEvaluateRuleToModel converts a filter rule given as input
+// into a rule model for newEngineRule, filling in the defaults of the filter
+// rule resource for omitted attributes.
+func convertFilterEvaluateRuleToModel(r *filterEvaluateRuleModel) *filterResourceModel {
+	m := &filterResourceModel{
+		Enabled:     boolOrDefault(r.Enabled, true),
+		Sequence:    types.Int64Value(1),
+		Description: r.Description,
+		Interface:   r.Interface,
+	}
+	if !r.Sequence.IsNull() {
+		m.Sequence = r.Sequence
+	}
+
+	if f := r.Filter; f != nil {
+		m.Filter = &filterFilterBlock{
+			Quick:         boolOrDefault(f.Quick, true),
+			Action:        f.Action,
+			Direction:     f.Direction,
+			IPProtocol:    types.StringValue("inet"),
+			Protocol:      f.Protocol,
+			ICMPType:      f.ICMPType,
+			Source:        f.Source,
+			Destination:   f.Destination,
+			TCPFlags:      f.TCPFlags,
+			TCPFlagsOutOf: f.TCPFlagsOutOf,
+			Schedule:      f.Schedule,
+		}
+		if !f.IPProtocol.IsNull() {
+			m.Filter.IPProtocol = f.IPProtocol
+		}
+	}
+
+	return m
+}
+
+// newEngineRulesFromInput converts filter rules given as input into engine
+// rules in evaluation order. Rules are identified by their index.
+func newEngineRulesFromInput(inputs []filterEvaluateRuleModel, snapshot *filterSnapshot) []engineRule {
+	rules := []engineRule{}
+	for i := range inputs {
+		id := fmt.Sprintf("rules[%d]", i)
+		if rule, ok := newEngineRule(id, convertFilterEvaluateRuleToModel(&inputs[i]), snapshot); ok {
+			rules = append(rules, rule)
+		}
+	}
+	sortEngineRules(rules)
+
+	return rules
+}
+
+func boolOrDefault(v types.Bool, d bool) types.Bool {
+	if v.IsNull() {
+		return types.BoolValue(d)
+	}
+	return v
+}
@@ -0,0 +1,116 @@
+package firewall
+
+import (
+	"fmt"
+	"net/netip"
+	"path/filepath"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateFilterRules(t *testing.T) {
+	paths, err := filepath.Glob("testdata/filter_evaluate/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		rules, fixture := loadFilterFixture(t, path)
+
+		for _, p := range fixture.Packets {
+			t.Run(p.Name, func(t *testing.T) {
+				packet := &enginePacket{
+					Interface:       p.Interface,
+					Direction:       p.Direction,
+					Protocol:        p.Protocol,
+					Source:          netip.MustParseAddr(p.Source),
+					SourcePort:      p.SourcePort,
+					Destination:     netip.MustParseAddr(p.Destination),
+					DestinationPort: p.DestinationPort,
+					ICMPType:        p.ICMPType,
+				}
+				if packet.Direction == "" {
+					packet.Direction = "in"
+				}
+
+				evaluation := evaluateFilterRules(rules, packet)
+
+				var trace []string
+				for _, step := range evaluation.Trace {
+					if step.Result == matchYes {
+						require.Empty(t, step.Reason)
+					} else {
+						require.NotEmpty(t, step.Reason)
+					}
+					trace = append(trace, fmt.Sprintf("%s:%s", step.RuleId, step.Result))
+				}
+
+				require.Equal(t, p.Action, evaluation.Action)
+				require.Equal(t, p.RuleId, evaluation.RuleId)
+				require.Equal(t, p.Indeterminate, evaluation.Indeterminate)
+				require.Equal(t, p.Trace, trace)
+			})
+		}
+	}
+}
+
+func TestNewEngineRulesFromInput(t *testing.T) {
+	snapshot := &filterSnapshot{
+		Aliases:    map[string]snapshotAlias{"web": {Type: "host", Content: []string{"192.0.2.10"}}},
+		Interfaces: map[string][]netip.Prefix{},
+	}
+	inputs := []filterEvaluateRuleModel{
+		{
+			Sequence:  types.Int64Value(20),
+			Interface: &filterInterfaceBlock{Interface: tools.StringSliceToSet([]string{"lan"})},
+			Filter: &filterEvaluateFilterBlock{
+				Action:    types.StringValue("block"),
+				Direction: types.StringValue("in"),
+				Protocol:  types.StringValue("any"),
+			},
+		},
+		{
+			Enabled:   types.BoolValue(false),
+			Interface: &filterInterfaceBlock{Interface: tools.StringSliceToSet([]string{"lan"})},
+			Filter: &filterEvaluateFilterBlock{
+				Action:    types.StringValue("reject"),
+				Direction: types.StringValue("in"),
+				Protocol:  types.StringValue("any"),
+			},
+		},
+		{
+			Interface: &filterInterfaceBlock{Interface: tools.StringSliceToSet([]string{"lan"})},
+			Filter: &filterEvaluateFilterBlock{
+				Action:      types.StringValue("pass"),
+				Direction:   types.StringValue("in"),
+				Protocol:    types.StringValue("TCP"),
+				Destination: &firewallLocation{Net: types.StringValue("web"), Port: types.StringValue("443")},
+			},
+		},
+	}
+
+	// Omitted attributes take the defaults of the filter rule resource:
+	// enabled, sequence 1, quick and inet
+	rules := newEngineRulesFromInput(inputs, snapshot)
+	require.Len(t, rules, 2)
+	require.Equal(t, "rules[2]", rules[0].Id)
+	require.Equal(t, int64(1), rules[0].Sequence)
+	require.True(t, rules[0].Quick)
+	require.Equal(t, "inet", rules[0].IPProtocol)
+	require.Equal(t, "rules[0]", rules[1].Id)
+
+	packet := &enginePacket{
+		Interface:       "lan",
+		Direction:       "in",
+		Protocol:        "TCP",
+		Source:          netip.MustParseAddr("10.1.2.3"),
+		Destination:     netip.MustParseAddr("192.0.2.10"),
+		DestinationPort: 443,
+	}
+	evaluation := evaluateFilterRules(rules, packet)
+	require.Equal(t, "pass", evaluation.Action)
+	require.Equal(t, "rules[2]", evaluation.RuleId)
+	require.False(t, evaluation.Indeterminate)
+}
@@ -0,0 +1,236 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// legacyFilterRule is a rule from the legacy (non-MVC) firewall pages,
+// converted to the model of opnsense_firewall_filter.
+type legacyFilterRule struct {
+	Tracker    string
+	Categories []string
+	Model      *filterResourceModel
+
+	// Unmapped lists the settings of the legacy rule that the converted rule
+	// does not have, as `<setting>: <reason>`.
+	Unmapped []string
+}
+
+// loadLegacyFilterRules reads the legacy filter rules, in evaluation order,
+// and converts them to filter rule models. They are numbered in that order,
+// after the sequence of the last automation rule.
+func loadLegacyFilterRules(ctx context.Context, client opnsense.Client) ([]legacyFilterRule, error) {
+	legacyRules, err := client.Firewall().ListLegacyFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list legacy firewall filter rules: %w", err)
+	}
+
+	categories, err := client.Firewall().ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall categories: %w", err)
+	}
+	categoryIds := map[string]string{}
+	for id, category := range categories {
+		categoryIds[category.Name] = id
+	}
+
+	// Migrated rules are evaluated after the existing automation rules
+	filters, err := client.Firewall().ListFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall filter rules: %w", err)
+	}
+	first := maxFilterSequence(filters) + 1
+
+	rules := []legacyFilterRule{}
+	for i := range legacyRules {
+		model, unmapped := convertLegacyFilterToSchema(&legacyRules[i], first+int64(i), categoryIds)
+		rules = append(rules, legacyFilterRule{
+			Tracker:    legacyRules[i].Tracker,
+			Categories: splitLegacyList(legacyRules[i].Category),
+			Model:      model,
+			Unmapped:   unmapped,
+		})
+	}
+
+	return rules, nil
+}
+
+// maxFilterSequence returns the highest sequence of the automation rules, or
+// 0 if there are none.
+func maxFilterSequence(filters map[string]firewall.Filter) int64 {
+	var sequence int64
+	for _, filter := range filters {
+		sequence = max(sequence, tools.StringToInt64(filter.Sequence))
+	}
+	return sequence
+}
+
+// convertLegacyFilterToSchema converts a legacy filter rule to a filter rule
+// model, with the defaults of opnsense_firewall_filter for everything the
+// legacy rule does not set. categoryIds maps category names to their UUID.
+//
+// Legacy interface rules are always quick and inbound; automation rules are
+// evaluated like legacy floating rules, so those settings are made explicit.
+func convertLegacyFilterToSchema(l *firewall.LegacyFilter, sequence int64, categoryIds map[string]string) (*filterResourceModel, []string) {
+	unmapped := []string{}
+	unsupported := func(setting, value, reason string) {
+		if value != "" {
+			unmapped = append(unmapped, fmt.Sprintf("%s: %s", setting, reason))
+		}
+	}
+
+	quick := true
+	direction := "in"
+	if tools.StringToBool(l.Floating) {
+		quick = tools.StringToBool(l.Quick)
+		direction = l.Direction
+		if direction == "" {
+			direction = "any"
+		}
+	}
+
+	ipProtocol := l.IPProtocol
+	if ipProtocol == "" {
+		ipProtocol = "inet"
+	}
+
+	protocol := "any"
+	if l.Protocol != "" && l.Protocol != "any" {
+		protocol = strings.ToUpper(l.Protocol)
+	}
+
+	categories := []string{}
+	for _, name := range splitLegacyList(l.Category) {
+		if id, ok := categoryIds[name]; ok {
+			categories = append(categories, id)
+		} else {
+			unmapped = append(unmapped, fmt.Sprintf("category: category %q does not exist", name))
+		}
+	}
+
+	stateType := strings.TrimSuffix(l.StateType, " state")
+	if stateType == "" {
+		stateType = "keep"
+	}
+
+	description := l.Description
+	if description == "" {
+		description = fmt.Sprintf("Migrated legacy rule %s", l.Tracker)
+	}
+
+	unsupported("os", l.OS, "operating system fingerprints are not supported by automation rules")
+	unsupported("dscp", l.DSCP, "DSCP matching is not supported by automation rules")
+	unsupported("defaultqueue", l.DefaultQueue, "ALTQ queues are not supported by automation rules, use traffic shaper rules")
+	unsupported("ackqueue", l.AckQueue, "ALTQ queues are not supported by automation rules, use traffic shaper rules")
+	unsupported("dnpipe", l.DNPipe, "limiter pipes are not supported by automation rules, use traffic shaper rules")
+	unsupported("pdnpipe", l.PDNPipe, "limiter pipes are not supported by automation rules, use traffic shaper rules")
+	unsupported("associated-rule-id", l.AssociatedRuleId, "the rule belongs to a port forward, use filter_rule_association on opnsense_firewall_nat_port_forward")
+
+	model := &filterResourceModel{
+		Enabled:      types.BoolValue(!tools.StringToBool(l.Disabled)),
+		Sequence:     types.Int64Value(sequence),
+		NoXMLRPCSync: types.BoolValue(tools.StringToBool(l.NoSync)),
+		Description:  types.StringValue(description),
+		Categories:   tools.StringSliceToSet(categories),
+		Interface: &filterInterfaceBlock{
+			Invert:    types.BoolValue(false),
+			Interface: tools.StringSliceToSet(splitLegacyList(l.Interface)),
+		},
+		Filter: &filterFilterBlock{
+			Quick:         types.BoolValue(quick),
+			Action:        types.StringValue(l.Type),
+			AllowOptions:  types.BoolValue(tools.StringToBool(l.AllowOpts)),
+			Direction:     types.StringValue(direction),
+			IPProtocol:    types.StringValue(ipProtocol),
+			Protocol:      types.StringValue(protocol),
+			ICMPType:      tools.StringSliceToSet(splitLegacyList(l.ICMPType)),
+			Source:        convertLegacyFilterEndpoint(&l.Source),
+			Destination:   convertLegacyFilterEndpoint(&l.Destination),
+			Log:           types.BoolValue(tools.StringToBool(l.Log)),
+			TCPFlags:      tools.StringSliceToSet(splitLegacyList(l.TCPFlags1)),
+			TCPFlagsOutOf: tools.StringSliceToSet(splitLegacyList(l.TCPFlags2)),
+			Schedule:      types.StringValue(l.Schedule),
+		},
+		StatefulFirewall: &filterStatefulFirewallBlock{
+			Type:    types.StringValue(stateType),
+			Policy:  types.StringValue(""),
+			Timeout: types.Int64Value(tools.StringToInt64(l.StateTimeout)),
+			AdaptiveTimeouts: &filterAdaptiveTimeouts{
+				Start: types.Int64Value(-1),
+				End:   types.Int64Value(-1),
+			},
+			Max: &filterMax{
+				States:            types.Int64Value(tools.StringToInt64(l.Max)),
+				SourceNodes:       types.Int64Value(tools.StringToInt64(l.MaxSrcNodes)),
+				SourceStates:      types.Int64Value(tools.StringToInt64(l.MaxSrcStates)),
+				SourceConnections: types.Int64Value(tools.StringToInt64(l.MaxSrcConn)),
+				NewConnections: &filterNewConnections{
+					Count:   types.Int64Value(tools.StringToInt64(l.MaxSrcConnRate)),
+					Seconds: types.Int64Value(tools.StringToInt64(l.MaxSrcConnRates)),
+				},
+			},
+			OverloadTable: types.StringValue(l.Overload),
+			NoPfsync:      types.BoolValue(false),
+		},
+		TrafficShaping: &filterTrafficShapingBlock{
+			Shaper:        types.StringValue(""),
+			ReverseShaper: types.StringValue(""),
+		},
+		SourceRouting: &filterSourceRoutingBlock{
+			Gateway:        types.StringValue(l.Gateway),
+			DisableReplyTo: types.BoolValue(tools.StringToBool(l.DisableReplyTo)),
+			ReplyTo:        types.StringValue(l.ReplyTo),
+		},
+		Priority: &filterPriorityBlock{
+			Match:       types.Int64Value(tools.StringToInt64(l.Prio)),
+			Set:         types.Int64Value(tools.StringToInt64(l.SetPrio)),
+			LowDelaySet: types.Int64Value(-1),
+			MatchTOS:    types.StringValue(""),
+		},
+		InternalTagging: &filterInternalTaggingBlock{
+			SetLocal:   types.StringValue(l.Tag),
+			MatchLocal: types.StringValue(l.Tagged),
+		},
+	}
+
+	return model, unmapped
+}
+
+// convertLegacyFilterEndpoint converts a legacy rule source or destination.
+// Legacy rules store interface networks (e.g. `lan`, `lanip`) separately
+// from addresses and aliases, automation rules accept both in `net`.
+func convertLegacyFilterEndpoint(e *firewall.LegacyFilterEndpoint) *firewallLocation {
+	net := "any"
+	switch {
+	case tools.StringToBool(e.Any):
+	case e.Network != "":
+		net = e.Network
+	case e.Address != "":
+		net = e.Address
+	}
+
+	return &firewallLocation{
+		Net:    types.StringValue(net),
+		Port:   types.StringValue(e.Port),
+		Invert: types.BoolValue(tools.StringToBool(e.Not)),
+	}
+}
+
+// splitLegacyList splits a comma-separated legacy setting, skipping empty
+// entries.
+func splitLegacyList(s string) []string {
+	result := []string{}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &filterLegacyDataSource{}
+var _ datasource.DataSourceWithConfigure = &filterLegacyDataSource{}
+
+func newFilterLegacyDataSource() datasource.DataSource {
+	return &filterLegacyDataSource{}
+}
+
+// filterLegacyDataSource defines the data source implementation.
+type filterLegacyDataSource struct {
+	client opnsense.Client
+}
+
+func (d *filterLegacyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_filter_legacy"
+}
+
+func (d *filterLegacyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = filterLegacyDataSourceSchema()
+}
+
+func (d *filterLegacyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *filterLegacyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *filterLegacyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get legacy filter rules from OPNsense API
+	rules, err := loadLegacyFilterRules(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read legacy firewall filter rules, got error: %s", err))
+		return
+	}
+
+	data.Rules = convertLegacyFilterRulesToSchema(rules)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
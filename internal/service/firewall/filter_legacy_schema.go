@@ -0,0 +1,219 @@
+package firewall
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type filterLegacyDataSourceModel struct {
+	Rules types.List `tfsdk:"rules"`
+}
+
+type filterLegacyRuleModel struct {
+	Tracker     types.String      `tfsdk:"tracker"`
+	Sequence    types.Int64       `tfsdk:"sequence"`
+	Enabled     types.Bool        `tfsdk:"enabled"`
+	Description types.String      `tfsdk:"description"`
+	Interface   types.Set         `tfsdk:"interface"`
+	Quick       types.Bool        `tfsdk:"quick"`
+	Action      types.String      `tfsdk:"action"`
+	Direction   types.String      `tfsdk:"direction"`
+	IPProtocol  types.String      `tfsdk:"ip_protocol"`
+	Protocol    types.String      `tfsdk:"protocol"`
+	Source      *firewallLocation `tfsdk:"source"`
+	Destination *firewallLocation `tfsdk:"destination"`
+	Gateway     types.String      `tfsdk:"gateway"`
+	Schedule    types.String      `tfsdk:"schedule"`
+	Log         types.Bool        `tfsdk:"log"`
+	Categories  types.List        `tfsdk:"categories"`
+	Unmapped    types.List        `tfsdk:"unmapped"`
+}
+
+var firewallLocationAttrTypes = map[string]attr.Type{
+	"net":    types.StringType,
+	"port":   types.StringType,
+	"invert": types.BoolType,
+}
+
+var filterLegacyRuleAttrTypes = map[string]attr.Type{
+	"tracker":     types.StringType,
+	"sequence":    types.Int64Type,
+	"enabled":     types.BoolType,
+	"description": types.StringType,
+	"interface":   types.SetType{ElemType: types.StringType},
+	"quick":       types.BoolType,
+	"action":      types.StringType,
+	"direction":   types.StringType,
+	"ip_protocol": types.StringType,
+	"protocol":    types.StringType,
+	"source":      types.ObjectType{AttrTypes: firewallLocationAttrTypes},
+	"destination": types.ObjectType{AttrTypes: firewallLocationAttrTypes},
+	"gateway":     types.StringType,
+	"schedule":    types.StringType,
+	"log":         types.BoolType,
+	"categories":  types.ListType{ElemType: types.StringType},
+	"unmapped":    types.ListType{ElemType: types.StringType},
+}
+
+func filterLegacyDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Lists the rules of the legacy (non-MVC) firewall rule pages, which `opnsense_firewall_filter` cannot manage, as they would be converted by the `opnsense_firewall_migrate_legacy_filter` action.",
+
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The legacy rules, in evaluation order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tracker": schema.StringAttribute{
+							MarkdownDescription: "Tracker ID of the legacy rule, which identifies it in the firewall log.",
+							Computed:            true,
+						},
+						"sequence": schema.Int64Attribute{
+							MarkdownDescription: "Sequence the rule gets when migrated: its position in the legacy rule set, counted on from the last automation rule, so that migrated rules are evaluated after the existing ones.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the rule is enabled.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the rule.",
+							Computed:            true,
+						},
+						"interface": schema.SetAttribute{
+							MarkdownDescription: "The interfaces the rule applies on. Empty for floating rules on all interfaces.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"quick": schema.BoolAttribute{
+							MarkdownDescription: "Whether the rule is quick. Legacy interface rules are always quick.",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action of the rule. One of `pass`, `block`, or `reject`.",
+							Computed:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Direction of the traffic. One of `in`, `out`, or `any`. Legacy interface rules are always `in`.",
+							Computed:            true,
+						},
+						"ip_protocol": schema.StringAttribute{
+							MarkdownDescription: "The Internet Protocol version of the rule. One of `inet`, `inet6`, or `inet46`.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "The IP protocol of the rule (e.g. `TCP`), or `any`.",
+							Computed:            true,
+						},
+						"source": schema.SingleNestedAttribute{
+							MarkdownDescription: "The source of the packets matched by the rule.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"net": schema.StringAttribute{
+									MarkdownDescription: "The IP address, CIDR, alias or interface network (e.g. `lan`, `lanip`) to match, or `any`.",
+									Computed:            true,
+								},
+								"port": schema.StringAttribute{
+									MarkdownDescription: "The port, port range or alias to match.",
+									Computed:            true,
+								},
+								"invert": schema.BoolAttribute{
+									MarkdownDescription: "Whether the sense of the match is inverted.",
+									Computed:            true,
+								},
+							},
+						},
+						"destination": schema.SingleNestedAttribute{
+							MarkdownDescription: "The destination of the packets matched by the rule.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"net": schema.StringAttribute{
+									MarkdownDescription: "The IP address, CIDR, alias or interface network (e.g. `lan`, `lanip`) to match, or `any`.",
+									Computed:            true,
+								},
+								"port": schema.StringAttribute{
+									MarkdownDescription: "The port, port range or alias to match.",
+									Computed:            true,
+								},
+								"invert": schema.BoolAttribute{
+									MarkdownDescription: "Whether the sense of the match is inverted.",
+									Computed:            true,
+								},
+							},
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "The gateway used for policy based routing, empty for the default route.",
+							Computed:            true,
+						},
+						"schedule": schema.StringAttribute{
+							MarkdownDescription: "Name of the schedule of the rule.",
+							Computed:            true,
+						},
+						"log": schema.BoolAttribute{
+							MarkdownDescription: "Whether packets matched by the rule are logged.",
+							Computed:            true,
+						},
+						"categories": schema.ListAttribute{
+							MarkdownDescription: "Names of the categories of the rule.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"unmapped": schema.ListAttribute{
+							MarkdownDescription: "Settings of the legacy rule that cannot be migrated, as `<setting>: <reason>`. Empty if the rule can be migrated as is.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertLegacyFilterRulesToSchema(rules []legacyFilterRule) types.List {
+	var models []filterLegacyRuleModel
+	for _, r := range rules {
+		m := r.Model
+		categories, _ := types.ListValueFrom(context.Background(), types.StringType, r.Categories)
+		unmapped, _ := types.ListValueFrom(context.Background(), types.StringType, r.Unmapped)
+
+		models = append(models, filterLegacyRuleModel{
+			Tracker:     types.StringValue(r.Tracker),
+			Sequence:    m.Sequence,
+			Enabled:     m.Enabled,
+			Description: m.Description,
+			Interface:   m.Interface.Interface,
+			Quick:       m.Filter.Quick,
+			Action:      m.Filter.Action,
+			Direction:   m.Filter.Direction,
+			IPProtocol:  m.Filter.IPProtocol,
+			Protocol:    m.Filter.Protocol,
+			Source:      m.Filter.Source,
+			Destination: m.Filter.Destination,
+			Gateway:     m.SourceRouting.Gateway,
+			Schedule:    m.Filter.Schedule,
+			Log:         m.Filter.Log,
+			Categories:  categories,
+			Unmapped:    unmapped,
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: filterLegacyRuleAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(models) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: filterLegacyRuleAttrTypes},
+			models,
+		)
+	}
+	return v
+}
@@ -0,0 +1,101 @@
+package firewall
+
+import (
+	"testing"
+
+	opnfirewall "github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertLegacyFilterToSchema(t *testing.T) {
+	model, unmapped := convertLegacyFilterToSchema(&opnfirewall.LegacyFilter{
+		Tracker:    "1700000001",
+		Type:       "pass",
+		Interface:  "lan",
+		IPProtocol: "inet",
+		Protocol:   "tcp/udp",
+		Source: opnfirewall.LegacyFilterEndpoint{
+			Network: "lan",
+		},
+		Destination: opnfirewall.LegacyFilterEndpoint{
+			Address: "dns_servers",
+			Port:    "53",
+		},
+		Description: "LAN to DNS",
+		Log:         "1",
+		Gateway:     "WAN_DHCP",
+		Category:    "dns,unknown",
+		StateType:   "sloppy state",
+		Tag:         "dns",
+	}, 3, map[string]string{"dns": "a1b2c3d4-0000-4000-8000-000000000001"})
+
+	require.Equal(t, []string{`category: category "unknown" does not exist`}, unmapped)
+	require.True(t, model.Enabled.ValueBool())
+	require.Equal(t, int64(3), model.Sequence.ValueInt64())
+	require.Equal(t, "LAN to DNS", model.Description.ValueString())
+	require.Equal(t, []string{"a1b2c3d4-0000-4000-8000-000000000001"}, tools.SetToStringSlice(model.Categories))
+	require.Equal(t, []string{"lan"}, tools.SetToStringSlice(model.Interface.Interface))
+
+	// Legacy interface rules are quick and inbound
+	require.True(t, model.Filter.Quick.ValueBool())
+	require.Equal(t, "in", model.Filter.Direction.ValueString())
+	require.Equal(t, "pass", model.Filter.Action.ValueString())
+	require.Equal(t, "TCP/UDP", model.Filter.Protocol.ValueString())
+	require.Equal(t, "lan", model.Filter.Source.Net.ValueString())
+	require.Equal(t, "dns_servers", model.Filter.Destination.Net.ValueString())
+	require.Equal(t, "53", model.Filter.Destination.Port.ValueString())
+	require.True(t, model.Filter.Log.ValueBool())
+	require.Equal(t, "sloppy", model.StatefulFirewall.Type.ValueString())
+	require.Equal(t, int64(-1), model.StatefulFirewall.Timeout.ValueInt64())
+	require.Equal(t, "WAN_DHCP", model.SourceRouting.Gateway.ValueString())
+	require.Equal(t, "dns", model.InternalTagging.SetLocal.ValueString())
+
+	// The converted model must be accepted by the filter rule conversion
+	result, err := convertFilterSchemaToStruct(model)
+	require.NoError(t, err)
+	require.Equal(t, "pass", result.Action.String())
+}
+
+func TestConvertLegacyFilterToSchemaFloating(t *testing.T) {
+	model, unmapped := convertLegacyFilterToSchema(&opnfirewall.LegacyFilter{
+		Tracker:  "1700000002",
+		Type:     "block",
+		Floating: "1",
+		Quick:    "0",
+		Source: opnfirewall.LegacyFilterEndpoint{
+			Any: "1",
+		},
+		Destination: opnfirewall.LegacyFilterEndpoint{
+			Address: "192.0.2.0/24",
+			Not:     "1",
+		},
+		Disabled:         "1",
+		OS:               "Windows",
+		AssociatedRuleId: "nat_5f0c1b2a3d4e5",
+	}, 1, map[string]string{})
+
+	require.Equal(t, []string{
+		"os: operating system fingerprints are not supported by automation rules",
+		"associated-rule-id: the rule belongs to a port forward, use filter_rule_association on opnsense_firewall_nat_port_forward",
+	}, unmapped)
+	require.False(t, model.Enabled.ValueBool())
+	require.Equal(t, "Migrated legacy rule 1700000002", model.Description.ValueString())
+	require.Empty(t, tools.SetToStringSlice(model.Interface.Interface))
+	require.False(t, model.Filter.Quick.ValueBool())
+	require.Equal(t, "any", model.Filter.Direction.ValueString())
+	require.Equal(t, "any", model.Filter.Protocol.ValueString())
+	require.Equal(t, "any", model.Filter.Source.Net.ValueString())
+	require.Equal(t, "192.0.2.0/24", model.Filter.Destination.Net.ValueString())
+	require.True(t, model.Filter.Destination.Invert.ValueBool())
+}
+
+func TestMaxFilterSequence(t *testing.T) {
+	require.Equal(t, int64(0), maxFilterSequence(nil))
+	require.Equal(t, int64(0), maxFilterSequence(map[string]opnfirewall.Filter{"a": {Sequence: ""}}))
+	require.Equal(t, int64(120), maxFilterSequence(map[string]opnfirewall.Filter{
+		"a": {Sequence: "1"},
+		"b": {Sequence: "120"},
+		"c": {Sequence: "15"},
+	}))
+}
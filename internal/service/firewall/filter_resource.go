@@ -8,6 +8,7 @@ import (
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
 	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/cache"
 	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +24,7 @@ var _ resource.ResourceWithConfigure = &filterResource{}
 var _ resource.ResourceWithImportState = &filterResource{}
 var _ resource.ResourceWithConfigValidators = &filterResource{}
 var _ resource.ResourceWithUpgradeState = &filterResource{}
+var _ resource.ResourceWithModifyPlan = &filterResource{}
 
 func newFilterResource() resource.Resource {
 	return &filterResource{}
@@ -30,7 +32,8 @@ func newFilterResource() resource.Resource {
 
 // filterResource defines the resource implementation.
 type filterResource struct {
-	client opnsense.Client
+	client     opnsense.Client
+	references *cache.Cache
 }
 
 func (r *filterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,6 +87,7 @@ func (r *filterResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 
 	r.client = opnsense.NewClient(apiClient)
+	r.references = cache.ForClient(apiClient)
 }
 
 func (r *filterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -231,6 +235,22 @@ func (r *filterResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *filterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	refs := newRuleReferences(r.client, r.references, req.Plan)
+	refs.ValidateNet(ctx, path.Root("filter").AtName("source").AtName("net"), &resp.Diagnostics)
+	refs.ValidatePort(ctx, path.Root("filter").AtName("source").AtName("port"), &resp.Diagnostics)
+	refs.ValidateNet(ctx, path.Root("filter").AtName("destination").AtName("net"), &resp.Diagnostics)
+	refs.ValidatePort(ctx, path.Root("filter").AtName("destination").AtName("port"), &resp.Diagnostics)
+	refs.ValidateSchedule(ctx, path.Root("filter").AtName("schedule"), &resp.Diagnostics)
+	refs.ValidateGateway(ctx, path.Root("source_routing").AtName("gateway"), &resp.Diagnostics)
+	refs.ValidateCategories(ctx, path.Root("categories"), &resp.Diagnostics)
+}
+
 func (r *filterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	schemaV0 := filterResourceSchemaV0()
 	return map[int64]resource.StateUpgrader{
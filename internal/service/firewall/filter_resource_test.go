@@ -2,6 +2,7 @@ package firewall_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
@@ -331,8 +332,39 @@ func TestAccFirewallFilterResource_DirectionAny(t *testing.T) {
 	})
 }
 
+func TestAccFirewallFilterResource_UnknownCategory(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Categories are referenced by UUID, which is known at plan time
+			// only for existing categories
+			{
+				Config:      testAccFirewallFilterResourceConfigCategory("00000000-0000-4000-8000-000000000000"),
+				ExpectError: regexp.MustCompile("Unknown Firewall Category"),
+			},
+		},
+	})
+}
+
 // Helper functions to generate test configurations
 
+func testAccFirewallFilterResourceConfigCategory(category string) string {
+	return fmt.Sprintf(`
+resource "opnsense_firewall_filter" "test" {
+  categories = [%[1]q]
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action = "pass"
+  }
+}
+`, category)
+}
+
 func testAccFirewallFilterResourceConfigFloating(action, direction, protocol string) string {
 	return fmt.Sprintf(`
 resource "opnsense_firewall_filter" "test" {
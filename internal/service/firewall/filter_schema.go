@@ -171,7 +171,7 @@ func filterResourceSchema() schema.Schema {
 				Optional:            true,
 			},
 			"categories": schema.SetAttribute{
-				MarkdownDescription: "For grouping purposes, provide the IDs of multiple groups here to organize items. Defaults to `[]`.",
+				MarkdownDescription: "For grouping purposes, provide the IDs of multiple groups here to organize items. IDs that do not match an existing category are rejected at plan time. Defaults to `[]`.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
@@ -427,9 +427,10 @@ func filterResourceSchema() schema.Schema {
 						),
 						Attributes: map[string]schema.Attribute{
 							"net": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								Default:  stringdefault.StaticString("any"),
+								MarkdownDescription: "Specify the IP address, CIDR or alias for the source of the packet. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Names that are not an existing alias or interface produce a warning at plan time. Defaults to `any`.",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString("any"),
 							},
 							"port": schema.StringAttribute{
 								MarkdownDescription: "Source port number or well known name (imap, imaps, http, https, ...), for ranges use a dash. Defaults to `\"\"`.",
@@ -468,9 +469,10 @@ func filterResourceSchema() schema.Schema {
 						),
 						Attributes: map[string]schema.Attribute{
 							"net": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								Default:  stringdefault.StaticString("any"),
+								MarkdownDescription: "Specify the IP address, CIDR or alias for the destination of the packet. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Names that are not an existing alias or interface produce a warning at plan time. Defaults to `any`.",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString("any"),
 							},
 							"port": schema.StringAttribute{
 								MarkdownDescription: "Destination port number or well known name (imap, imaps, http, https, ...), for ranges use a dash. Defaults to `\"\"`.",
@@ -511,9 +513,10 @@ func filterResourceSchema() schema.Schema {
 						Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
 					},
 					"schedule": schema.StringAttribute{
-						Optional: true,
-						Computed: true,
-						Default:  stringdefault.StaticString(""),
+						MarkdownDescription: "Name of the schedule (see `opnsense_firewall_schedule`) during which this rule is active. Leave empty for a rule that is always active. Names that are not an existing schedule produce a warning at plan time. Defaults to `\"\"`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
 					},
 				},
 			},
@@ -609,7 +612,7 @@ func filterResourceSchema() schema.Schema {
 						},
 					},
 					"policy": schema.StringAttribute{
-						MarkdownDescription: "How states created by this rule are treated, default (as defined in advanced), floating in which case states are valid on all interfaces or interface bound. Interface bound states are more secure, floating more flexible. Defaults to `\"\"`.",
+						MarkdownDescription: "How states created by this rule are treated, default (`state_policy` of `opnsense_firewall_settings`), floating in which case states are valid on all interfaces or interface bound. Interface bound states are more secure, floating more flexible. Defaults to `\"\"`.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
@@ -814,7 +817,7 @@ func filterResourceSchema() schema.Schema {
 				),
 				Attributes: map[string]schema.Attribute{
 					"gateway": schema.StringAttribute{
-						MarkdownDescription: "Leave as 'default' to use the system routing table. Or choose a gateway to utilize policy based routing. Defaults to `\"\"`.",
+						MarkdownDescription: "Leave as 'default' to use the system routing table. Or choose a gateway or gateway group to utilize policy based routing. Names that are not an existing gateway or gateway group produce a warning at plan time. Defaults to `\"\"`.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
@@ -826,7 +829,7 @@ func filterResourceSchema() schema.Schema {
 						Default:             booldefault.StaticBool(false),
 					},
 					"reply_to": schema.StringAttribute{
-						MarkdownDescription: "Determines how packets route back in the opposite direction (replies), when set to default, packets on WAN type interfaces reply to their connected gateway on the interface (unless globally disabled). A specific gateway may be chosen as well here. This setting is only relevant in the context of a state, for stateless rules there is no defined opposite direction. Defaults to `\"\"`.",
+						MarkdownDescription: "Determines how packets route back in the opposite direction (replies), when set to default, packets on WAN type interfaces reply to their connected gateway on the interface (unless globally disabled with `disable_reply_to` of `opnsense_firewall_settings`). A specific gateway may be chosen as well here. This setting is only relevant in the context of a state, for stateless rules there is no defined opposite direction. Defaults to `\"\"`.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
@@ -1065,7 +1068,8 @@ func filterDataSourceSchema() dschema.Schema {
 						ElementType:         types.StringType,
 					},
 					"schedule": dschema.StringAttribute{
-						Computed: true,
+						MarkdownDescription: "Name of the schedule during which this rule is active.",
+						Computed:            true,
 					},
 				},
 			},
@@ -1077,7 +1081,7 @@ func filterDataSourceSchema() dschema.Schema {
 						Computed:            true,
 					},
 					"policy": dschema.StringAttribute{
-						MarkdownDescription: "How states created by this rule are treated, default (as defined in advanced), floating in which case states are valid on all interfaces or interface bound. Interface bound states are more secure, floating more flexible.",
+						MarkdownDescription: "How states created by this rule are treated, default (`state_policy` of `opnsense_firewall_settings`), floating in which case states are valid on all interfaces or interface bound. Interface bound states are more secure, floating more flexible.",
 						Computed:            true,
 					},
 					"timeout": dschema.Int64Attribute{
@@ -1166,7 +1170,7 @@ func filterDataSourceSchema() dschema.Schema {
 						Computed:            true,
 					},
 					"reply_to": dschema.StringAttribute{
-						MarkdownDescription: "How packets route back in the opposite direction (replies), when set to default, packets on WAN type interfaces reply to their connected gateway on the interface (unless globally disabled). A specific gateway may be chosen as well here. This setting is only relevant in the context of a state, for stateless rules there is no defined opposite direction.",
+						MarkdownDescription: "How packets route back in the opposite direction (replies), when set to default, packets on WAN type interfaces reply to their connected gateway on the interface (unless globally disabled with `disable_reply_to` of `opnsense_firewall_settings`). A specific gateway may be chosen as well here. This setting is only relevant in the context of a state, for stateless rules there is no defined opposite direction.",
 						Computed:            true,
 					},
 				},
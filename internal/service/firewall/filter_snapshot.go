@@ -0,0 +1,96 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+)
+
+// loadFilterRules fetches every filter rule, and the aliases and interface
+// addresses they refer to, and converts them into engine rules in evaluation
+// order. Disabled rules are skipped.
+func loadFilterRules(ctx context.Context, client opnsense.Client) ([]engineRule, error) {
+	snapshot, err := loadFilterSnapshot(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadFilterRulesWithSnapshot(ctx, client, snapshot)
+}
+
+// loadFilterRulesWithSnapshot fetches every filter rule and converts them
+// into engine rules in evaluation order, expanded against snapshot.
+func loadFilterRulesWithSnapshot(ctx context.Context, client opnsense.Client, snapshot *filterSnapshot) ([]engineRule, error) {
+	filters, err := client.Firewall().ListFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall filter rules: %w", err)
+	}
+
+	rules := []engineRule{}
+	for id, filter := range filters {
+		model, err := convertFilterStructToSchema(&filter)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert firewall filter rule %q: %w", id, err)
+		}
+
+		if rule, ok := newEngineRule(id, model, snapshot); ok {
+			rules = append(rules, rule)
+		}
+	}
+	sortEngineRules(rules)
+
+	return rules, nil
+}
+
+// loadFilterSnapshot fetches the aliases and interface addresses that filter
+// rules are expanded against.
+func loadFilterSnapshot(ctx context.Context, client opnsense.Client) (*filterSnapshot, error) {
+	snapshot := &filterSnapshot{
+		Aliases:    map[string]snapshotAlias{},
+		Interfaces: map[string][]netip.Prefix{},
+	}
+
+	aliases, err := client.Firewall().ListAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list firewall aliases: %w", err)
+	}
+	for _, alias := range aliases {
+		if !tools.StringToBool(alias.Enabled) {
+			continue
+		}
+		snapshot.Aliases[alias.Name] = snapshotAlias{
+			Type:    alias.Type.String(),
+			Content: alias.Content,
+		}
+	}
+
+	overview, err := client.Interfaces().OverviewGet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read interfaces overview: %w", err)
+	}
+	for _, iface := range overview.Rows {
+		if iface.Identifier == "" {
+			continue
+		}
+
+		addresses := []string{iface.Addr4, iface.Addr6}
+		for _, ip := range iface.IPv4 {
+			addresses = append(addresses, ip.IPAddr)
+		}
+		for _, ip := range iface.IPv6 {
+			addresses = append(addresses, ip.IPAddr)
+		}
+
+		for _, address := range addresses {
+			// Skip empty and link-local (scoped) addresses
+			if prefix, err := netip.ParsePrefix(address); err == nil {
+				snapshot.Interfaces[iface.Identifier] = append(snapshot.Interfaces[iface.Identifier], prefix)
+			}
+		}
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,110 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &killStatesAction{}
+var _ action.ActionWithConfigure = &killStatesAction{}
+var _ action.ActionWithConfigValidators = &killStatesAction{}
+
+func newKillStatesAction() action.Action {
+	return &killStatesAction{}
+}
+
+type killStatesAction struct {
+	client opnsense.Client
+}
+
+type killStatesActionModel struct {
+	RuleId types.String `tfsdk:"rule_id"`
+	Filter types.String `tfsdk:"filter"`
+}
+
+func (a *killStatesAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_kill_states"
+}
+
+func (a *killStatesAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Kills entries in the pf state table. Changing a filter rule does not terminate connections that were already established, so trigger this action after changing a rule (e.g. from `pass` to `block`) to make the change take effect immediately. If both `rule_id` and `filter` are set, only states matching both are killed.",
+
+		Attributes: map[string]schema.Attribute{
+			"rule_id": schema.StringAttribute{
+				MarkdownDescription: "Kill all states created by the filter rule with this UUID (e.g. `opnsense_firewall_filter.example.id`).",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsUUIDv4(),
+				},
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "Kill all states matching this expression, such as a host address, a network in CIDR notation or an interface device. Uses the same syntax as the state table search in the web interface.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *killStatesAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("rule_id"),
+			path.MatchRoot("filter"),
+		),
+	}
+}
+
+func (a *killStatesAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = opnsense.NewClient(apiClient)
+}
+
+func (a *killStatesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data killStatesActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.Diagnostics().KillStates(ctx, &diagnostics.KillStatesRequest{
+		Label:  data.RuleId.ValueString(),
+		Filter: data.Filter.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to kill firewall states, got error: %s", err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Killed %d firewall state(s)", result.DroppedStates),
+	})
+
+	tflog.Trace(ctx, "invoked firewall kill states action", map[string]any{"dropped_states": result.DroppedStates})
+}
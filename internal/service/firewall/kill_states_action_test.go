@@ -0,0 +1,99 @@
+package firewall_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Actions are only supported in Terraform 1.14 and later.
+var version1_14_0 = version.Must(version.NewVersion("1.14.0"))
+
+func TestAccFirewallKillStatesAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(version1_14_0)},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The action is invoked after the rule action is set and after it changes
+			{
+				Config: testAccFirewallKillStatesActionConfig("pass"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_filter.test", "filter.action", "pass"),
+				),
+			},
+			{
+				Config: testAccFirewallKillStatesActionConfig("block"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_filter.test", "filter.action", "block"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFirewallKillStatesAction_MissingSelector(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(version1_14_0)},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+action "opnsense_firewall_kill_states" "test" {
+  config {}
+}
+
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.opnsense_firewall_kill_states.test]
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccFirewallKillStatesActionConfig(ruleAction string) string {
+	return `
+action "opnsense_firewall_kill_states" "test" {
+  config {
+    rule_id = opnsense_firewall_filter.test.id
+  }
+}
+
+resource "opnsense_firewall_filter" "test" {
+  description = "Testing kill states"
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action    = "` + ruleAction + `"
+    direction = "in"
+    protocol  = "any"
+  }
+}
+
+resource "terraform_data" "test" {
+  input = opnsense_firewall_filter.test.filter.action
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.opnsense_firewall_kill_states.test]
+    }
+  }
+}
+`
+}
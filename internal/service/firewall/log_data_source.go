@@ -0,0 +1,125 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &logDataSource{}
+var _ datasource.DataSourceWithConfigure = &logDataSource{}
+
+func newLogDataSource() datasource.DataSource {
+	return &logDataSource{}
+}
+
+// logDataSource defines the data source implementation.
+type logDataSource struct {
+	client opnsense.Client
+}
+
+func (d *logDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_log"
+}
+
+func (d *logDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = logDataSourceSchema()
+}
+
+func (d *logDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+// logPageSize is the number of entries requested from the log at once.
+const logPageSize = 1000
+
+func (d *logDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *logDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(1000)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	filter := &logFilter{
+		RuleId:      data.RuleId.ValueString(),
+		Label:       data.Label.ValueString(),
+		Interface:   data.Interface.ValueString(),
+		Action:      data.Action.ValueString(),
+		Source:      data.Source.ValueString(),
+		Destination: data.Destination.ValueString(),
+	}
+	if !data.Since.IsNull() {
+		since, err := time.ParseDuration(data.Since.ValueString())
+		if err != nil || since <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("since"), "Invalid Duration",
+				fmt.Sprintf("Expected a positive duration (e.g. 15m), got: %q", data.Since.ValueString()))
+			return
+		}
+		filter.NotBefore = time.Now().Add(-since)
+	}
+
+	// Get log entries from OPNsense API, newest first and page by page, until
+	// enough entries match or the time window is covered. All filters are
+	// applied on the result, as the log API only supports paging.
+	var entries []diagnostics.FirewallLogEntry
+	for page := int64(1); int64(len(entries)) < limit; page++ {
+		rows, err := d.client.Diagnostics().QueryFirewallLog(ctx, &diagnostics.FirewallLogQuery{
+			RowCount: logPageSize,
+			Current:  page,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read firewall log, got error: %s", err))
+			return
+		}
+
+		for i := range rows {
+			if logEntryMatchesFilter(&rows[i], filter) {
+				entries = append(entries, rows[i])
+			}
+		}
+
+		// The last page is reached, or older entries are outside the window
+		if int64(len(rows)) < logPageSize || logEntryBefore(&rows[len(rows)-1], filter.NotBefore) {
+			break
+		}
+	}
+	if int64(len(entries)) > limit {
+		entries = entries[:limit]
+	}
+
+	// Convert OPNsense struct to TF schema
+	data.Entries = convertLogEntriesToSchema(entries)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,55 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallLogDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallLogDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_log.recent", "entries.#"),
+					// Nothing is sent to the documentation range, so the new rule has not logged anything
+					resource.TestCheckResourceAttr("data.opnsense_firewall_log.rule", "entries.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallLogDataSourceConfig() string {
+	return `
+resource "opnsense_firewall_filter" "test" {
+  description = "Testing firewall log"
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action = "block"
+    log    = true
+    destination = {
+      net = "203.0.113.0/24"
+    }
+  }
+}
+
+data "opnsense_firewall_log" "recent" {
+  since = "1h"
+  limit = 100
+}
+
+data "opnsense_firewall_log" "rule" {
+  rule_id = opnsense_firewall_filter.test.id
+  action  = "block"
+}
+`
+}
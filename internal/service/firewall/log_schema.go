@@ -0,0 +1,279 @@
+package firewall
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type logDataSourceModel struct {
+	RuleId      types.String `tfsdk:"rule_id"`
+	Label       types.String `tfsdk:"label"`
+	Interface   types.String `tfsdk:"interface"`
+	Action      types.String `tfsdk:"action"`
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	Since       types.String `tfsdk:"since"`
+	Limit       types.Int64  `tfsdk:"limit"`
+	Entries     types.List   `tfsdk:"entries"`
+}
+
+type logEntryModel struct {
+	Timestamp          types.String `tfsdk:"timestamp"`
+	Interface          types.String `tfsdk:"interface"`
+	Action             types.String `tfsdk:"action"`
+	Reason             types.String `tfsdk:"reason"`
+	Direction          types.String `tfsdk:"direction"`
+	IpProtocol         types.String `tfsdk:"ip_protocol"`
+	Protocol           types.String `tfsdk:"protocol"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	SourcePort         types.String `tfsdk:"source_port"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	DestinationPort    types.String `tfsdk:"destination_port"`
+	RuleId             types.String `tfsdk:"rule_id"`
+	Label              types.String `tfsdk:"label"`
+	Length             types.Int64  `tfsdk:"length"`
+}
+
+var logEntryAttrTypes = map[string]attr.Type{
+	"timestamp":           types.StringType,
+	"interface":           types.StringType,
+	"action":              types.StringType,
+	"reason":              types.StringType,
+	"direction":           types.StringType,
+	"ip_protocol":         types.StringType,
+	"protocol":            types.StringType,
+	"source_address":      types.StringType,
+	"source_port":         types.StringType,
+	"destination_address": types.StringType,
+	"destination_port":    types.StringType,
+	"rule_id":             types.StringType,
+	"label":               types.StringType,
+	"length":              types.Int64Type,
+}
+
+func logDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Log returns recent entries from the firewall log, optionally filtered by rule, interface, action, address and time window. Only packets matched by rules with logging enabled (e.g. `filter.log` of `opnsense_firewall_filter`) and the default rules are logged.",
+
+		Attributes: map[string]schema.Attribute{
+			"rule_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries logged by the filter rule with this UUID (e.g. `opnsense_firewall_filter.example.id`).",
+				Optional:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Only return entries logged by rules with this label, which is the description of the rule (e.g. `Default deny / state violation rule`).",
+				Optional:            true,
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Only return entries logged on this interface device (e.g. `vtnet0`).",
+				Optional:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Only return entries with this action. One of `pass`, `block`, `rdr`, `nat` or `binat`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pass", "block", "rdr", "nat", "binat"),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Only return entries whose source address is this IP address, or is within this CIDR.",
+				Optional:            true,
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "Only return entries whose destination address is this IP address, or is within this CIDR.",
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return entries logged within this duration before the data source is read, as a Go duration (e.g. `15m`, `1h`).",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of matching entries to return, after filtering. The log is read back until this many entries match, or until `since` is covered. Defaults to `1000` if omitted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of matching log entries, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "Time the packet was logged, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Interface device the packet was logged on.",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action taken on the packet (e.g. `pass`, `block`).",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "Reason the packet was logged (e.g. `match`).",
+							Computed:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Direction of the packet, either `in` or `out`.",
+							Computed:            true,
+						},
+						"ip_protocol": schema.StringAttribute{
+							MarkdownDescription: "IP version of the packet (e.g. `inet`).",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol of the packet (e.g. `tcp`).",
+							Computed:            true,
+						},
+						"source_address": schema.StringAttribute{
+							MarkdownDescription: "Source address of the packet.",
+							Computed:            true,
+						},
+						"source_port": schema.StringAttribute{
+							MarkdownDescription: "Source port of the packet, if any.",
+							Computed:            true,
+						},
+						"destination_address": schema.StringAttribute{
+							MarkdownDescription: "Destination address of the packet.",
+							Computed:            true,
+						},
+						"destination_port": schema.StringAttribute{
+							MarkdownDescription: "Destination port of the packet, if any.",
+							Computed:            true,
+						},
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the rule that logged the packet, if known.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Label of the rule that logged the packet.",
+							Computed:            true,
+						},
+						"length": schema.Int64Attribute{
+							MarkdownDescription: "Length of the packet in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// logFilter holds the filters of the log data source. Empty filters match
+// everything.
+type logFilter struct {
+	RuleId      string
+	Label       string
+	Interface   string
+	Action      string
+	Source      string
+	Destination string
+	NotBefore   time.Time
+}
+
+// logEntryMatchesFilter returns true if the log entry matches all filters.
+// Entries without a valid timestamp never match a time window.
+func logEntryMatchesFilter(e *diagnostics.FirewallLogEntry, f *logFilter) bool {
+	if f.RuleId != "" && e.RuleId != f.RuleId {
+		return false
+	}
+	if f.Label != "" && e.Label != f.Label {
+		return false
+	}
+	if f.Interface != "" && e.Interface != f.Interface {
+		return false
+	}
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+	if f.Source != "" && !logAddressMatches(e.Source, f.Source) {
+		return false
+	}
+	if f.Destination != "" && !logAddressMatches(e.Destination, f.Destination) {
+		return false
+	}
+	if !f.NotBefore.IsZero() {
+		t, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil || t.Before(f.NotBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+// logAddressMatches returns true if address equals filter, or is within
+// filter if it is a CIDR.
+func logAddressMatches(address string, filter string) bool {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	if prefix, err := netip.ParsePrefix(filter); err == nil {
+		return prefix.Contains(addr)
+	}
+	if filterAddr, err := netip.ParseAddr(filter); err == nil {
+		return filterAddr == addr
+	}
+	return false
+}
+
+// logEntryBefore returns true if the log entry was logged before t. Entries
+// without a valid timestamp, or a zero t, are never before t.
+func logEntryBefore(e *diagnostics.FirewallLogEntry, t time.Time) bool {
+	if t.IsZero() {
+		return false
+	}
+	logged, err := time.Parse(time.RFC3339, e.Timestamp)
+	return err == nil && logged.Before(t)
+}
+
+func convertLogEntriesToSchema(d []diagnostics.FirewallLogEntry) types.List {
+	var entries []logEntryModel
+	for i := range d {
+		e := &d[i]
+		entries = append(entries, logEntryModel{
+			Timestamp:          types.StringValue(e.Timestamp),
+			Interface:          types.StringValue(e.Interface),
+			Action:             types.StringValue(e.Action),
+			Reason:             types.StringValue(e.Reason),
+			Direction:          types.StringValue(e.Direction),
+			IpProtocol:         types.StringValue(e.IpProtocol),
+			Protocol:           types.StringValue(e.Protocol),
+			SourceAddress:      types.StringValue(e.Source),
+			SourcePort:         types.StringValue(e.SourcePort),
+			DestinationAddress: types.StringValue(e.Destination),
+			DestinationPort:    types.StringValue(e.DestinationPort),
+			RuleId:             types.StringValue(e.RuleId),
+			Label:              types.StringValue(e.Label),
+			Length:             types.Int64Value(e.Length),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: logEntryAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(entries) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: logEntryAttrTypes},
+			entries,
+		)
+	}
+
+	return v
+}
@@ -0,0 +1,62 @@
+package firewall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogEntryMatchesFilter(t *testing.T) {
+	entry := &diagnostics.FirewallLogEntry{
+		Timestamp:   "2026-10-18T12:00:00Z",
+		Interface:   "vtnet1",
+		Action:      "block",
+		Source:      "192.0.2.10",
+		Destination: "198.51.100.1",
+		RuleId:      "b1b8c3a4-0000-4000-8000-000000000001",
+		Label:       "Block TEST-NET",
+	}
+
+	tests := map[string]struct {
+		filter logFilter
+		want   bool
+	}{
+		"empty":                  {logFilter{}, true},
+		"rule id":                {logFilter{RuleId: "b1b8c3a4-0000-4000-8000-000000000001"}, true},
+		"other rule id":          {logFilter{RuleId: "b1b8c3a4-0000-4000-8000-000000000002"}, false},
+		"label":                  {logFilter{Label: "Block TEST-NET"}, true},
+		"interface and action":   {logFilter{Interface: "vtnet1", Action: "block"}, true},
+		"other action":           {logFilter{Action: "pass"}, false},
+		"source address":         {logFilter{Source: "192.0.2.10"}, true},
+		"source cidr":            {logFilter{Source: "192.0.2.0/24"}, true},
+		"other source cidr":      {logFilter{Source: "203.0.113.0/24"}, false},
+		"destination address":    {logFilter{Destination: "198.51.100.1"}, true},
+		"invalid destination":    {logFilter{Destination: "lan"}, false},
+		"within time window":     {logFilter{NotBefore: time.Date(2026, 10, 18, 11, 45, 0, 0, time.UTC)}, true},
+		"outside of time window": {logFilter{NotBefore: time.Date(2026, 10, 18, 12, 15, 0, 0, time.UTC)}, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.want, logEntryMatchesFilter(entry, &tt.filter))
+		})
+	}
+}
+
+func TestLogEntryMatchesFilterInvalidTimestamp(t *testing.T) {
+	entry := &diagnostics.FirewallLogEntry{Timestamp: "Oct 18 12:00:00"}
+
+	require.True(t, logEntryMatchesFilter(entry, &logFilter{}))
+	require.False(t, logEntryMatchesFilter(entry, &logFilter{NotBefore: time.Now().Add(-time.Hour)}))
+}
+
+func TestLogEntryBefore(t *testing.T) {
+	entry := &diagnostics.FirewallLogEntry{Timestamp: "2026-10-18T12:00:00Z"}
+
+	require.True(t, logEntryBefore(entry, time.Date(2026, 10, 18, 12, 15, 0, 0, time.UTC)))
+	require.False(t, logEntryBefore(entry, time.Date(2026, 10, 18, 11, 45, 0, 0, time.UTC)))
+	require.False(t, logEntryBefore(entry, time.Time{}))
+	require.False(t, logEntryBefore(&diagnostics.FirewallLogEntry{Timestamp: "Oct 18 12:00:00"}, time.Now()))
+}
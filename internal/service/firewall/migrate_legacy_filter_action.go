@@ -0,0 +1,140 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &migrateLegacyFilterAction{}
+var _ action.ActionWithConfigure = &migrateLegacyFilterAction{}
+
+func newMigrateLegacyFilterAction() action.Action {
+	return &migrateLegacyFilterAction{}
+}
+
+type migrateLegacyFilterAction struct {
+	client opnsense.Client
+}
+
+type migrateLegacyFilterActionModel struct {
+	Trackers          types.Set  `tfsdk:"trackers"`
+	IncludeUnmappable types.Bool `tfsdk:"include_unmappable"`
+}
+
+func (a *migrateLegacyFilterAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_migrate_legacy_filter"
+}
+
+func (a *migrateLegacyFilterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Copies rules from the legacy (non-MVC) firewall rule pages to automation rules, which can then be imported into `opnsense_firewall_filter`. Each migrated rule is reported with the UUID of the new rule. The legacy rules are left unchanged; disable them once the automation rules are in place. Invoking the action again creates the rules again, so use `opnsense_firewall_filter_legacy` to review the rules first and invoke it once with `terraform apply -invoke`.",
+
+		Attributes: map[string]schema.Attribute{
+			"trackers": schema.SetAttribute{
+				MarkdownDescription: "Tracker IDs of the legacy rules to migrate (see `opnsense_firewall_filter_legacy`). Migrates all legacy rules if omitted.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"include_unmappable": schema.BoolAttribute{
+				MarkdownDescription: "Also migrate rules with settings that automation rules do not support, without those settings. By default, such rules are skipped and reported. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *migrateLegacyFilterAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = opnsense.NewClient(apiClient)
+}
+
+func (a *migrateLegacyFilterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data migrateLegacyFilterActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := loadLegacyFilterRules(ctx, a.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read legacy firewall filter rules, got error: %s", err))
+		return
+	}
+
+	trackers := tools.SetToStringSlice(data.Trackers)
+	for _, tracker := range trackers {
+		if !slices.ContainsFunc(rules, func(r legacyFilterRule) bool { return r.Tracker == tracker }) {
+			resp.Diagnostics.AddWarning("Legacy Rule Not Found",
+				fmt.Sprintf("No legacy firewall filter rule has tracker %q.", tracker))
+		}
+	}
+
+	migrated, skipped := 0, 0
+	for _, rule := range rules {
+		if len(trackers) > 0 && !slices.Contains(trackers, rule.Tracker) {
+			continue
+		}
+
+		if len(rule.Unmapped) > 0 {
+			message := fmt.Sprintf("Legacy rule %s (%s) has settings that cannot be migrated:\n  - %s",
+				rule.Tracker, rule.Model.Description.ValueString(), strings.Join(rule.Unmapped, "\n  - "))
+
+			if !data.IncludeUnmappable.ValueBool() {
+				resp.Diagnostics.AddWarning("Legacy Rule Skipped", message+"\n\nSet include_unmappable to migrate it without these settings.")
+				skipped++
+				continue
+			}
+			resp.Diagnostics.AddWarning("Legacy Rule Migrated Partially", message)
+		}
+
+		// Convert TF schema OPNsense struct
+		resourceStruct, err := convertFilterSchemaToStruct(rule.Model)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to parse legacy firewall filter rule %s, got error: %s", rule.Tracker, err))
+			return
+		}
+
+		id, err := a.client.Firewall().AddFilter(ctx, resourceStruct)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to migrate legacy firewall filter rule %s, got error: %s", rule.Tracker, err))
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Migrated legacy rule %s (%s) to firewall filter rule %s", rule.Tracker, rule.Model.Description.ValueString(), id),
+		})
+		migrated++
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Migrated %d legacy firewall filter rule(s), skipped %d", migrated, skipped),
+	})
+
+	tflog.Trace(ctx, "invoked firewall migrate legacy filter action", map[string]any{"migrated": migrated, "skipped": skipped})
+}
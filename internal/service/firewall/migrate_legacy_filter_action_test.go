@@ -0,0 +1,38 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFirewallMigrateLegacyFilterAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(version1_14_0)},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// An unknown tracker only warns, and migrates nothing
+			{
+				Config: `
+action "opnsense_firewall_migrate_legacy_filter" "test" {
+  config {
+    trackers = ["0"]
+  }
+}
+
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.opnsense_firewall_migrate_legacy_filter.test]
+    }
+  }
+}
+`,
+			},
+		},
+	})
+}
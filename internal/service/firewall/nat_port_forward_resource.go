@@ -8,6 +8,7 @@ import (
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
 	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/cache"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +19,7 @@ import (
 var _ resource.Resource = &natPortForwardResource{}
 var _ resource.ResourceWithConfigure = &natPortForwardResource{}
 var _ resource.ResourceWithImportState = &natPortForwardResource{}
+var _ resource.ResourceWithModifyPlan = &natPortForwardResource{}
 var _ resource.ResourceWithUpgradeState = &natPortForwardResource{}
 
 func newNATPortForwardResource() resource.Resource {
@@ -26,7 +28,8 @@ func newNATPortForwardResource() resource.Resource {
 
 // natPortForwardResource defines the resource implementation.
 type natPortForwardResource struct {
-	client opnsense.Client
+	client     opnsense.Client
+	references *cache.Cache
 }
 
 func (r *natPortForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +56,7 @@ func (r *natPortForwardResource) Configure(ctx context.Context, req resource.Con
 	}
 
 	r.client = opnsense.NewClient(apiClient)
+	r.references = cache.ForClient(apiClient)
 }
 
 func (r *natPortForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -65,6 +69,18 @@ func (r *natPortForwardResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Add the linked filter rule first, so the port forward can reference it
+	data.FilterRuleId = types.StringValue("")
+	if data.FilterRuleAssociation.ValueString() == "managed" {
+		filterRuleId, err := r.client.Firewall().AddFilter(ctx, natPortForwardFilterRule(data))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to create firewall nat port forward filter rule, got error: %s", err))
+			return
+		}
+		data.FilterRuleId = types.StringValue(filterRuleId)
+	}
+
 	// Convert TF schema OPNsense struct
 	portForward, err := convertNATPortForwardSchemaToStruct(data)
 	if err != nil {
@@ -76,6 +92,15 @@ func (r *natPortForwardResource) Create(ctx context.Context, req resource.Create
 	// Add firewall nat port forward
 	id, err := r.client.Firewall().AddNatPortForward(ctx, portForward)
 	if err != nil {
+		// Remove the linked filter rule, unless the port forward was created
+		// and is tracked in state below
+		if id == "" && data.FilterRuleId.ValueString() != "" {
+			if deleteErr := r.client.Firewall().DeleteFilter(ctx, data.FilterRuleId.ValueString()); deleteErr != nil {
+				resp.Diagnostics.AddWarning("Client Error",
+					fmt.Sprintf("Unable to remove firewall nat port forward filter rule %s, got error: %s", data.FilterRuleId.ValueString(), deleteErr))
+			}
+		}
+
 		if id != "" {
 			data.Id = types.StringValue(id)
 
@@ -143,25 +168,77 @@ func (r *natPortForwardResource) Read(ctx context.Context, req resource.ReadRequ
 	// ID cannot be added by convert... func, have to add here
 	resourceModel.Id = data.Id
 
+	// If the linked filter rule was removed outside Terraform, drop the
+	// association so the next apply recreates it
+	if resourceModel.FilterRuleAssociation.ValueString() == "managed" {
+		_, err := r.client.Firewall().GetFilter(ctx, resourceModel.FilterRuleId.ValueString())
+		if err != nil {
+			var notFoundError *errs.NotFoundError
+			if !errors.As(err, &notFoundError) {
+				resp.Diagnostics.AddError("Client Error",
+					fmt.Sprintf("Unable to read firewall nat port forward filter rule, got error: %s", err))
+				return
+			}
+
+			tflog.Warn(ctx, "firewall nat port forward filter rule not present in remote, removing association from state")
+			resourceModel.FilterRuleAssociation = types.StringValue("none")
+			resourceModel.FilterRuleId = types.StringValue("")
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
 }
 
 func (r *natPortForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *natPortForwardResourceModel
+	var data, state *natPortForwardResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Create or update the linked filter rule, so it matches the port forward
+	staleFilterRuleId := ""
+	if state.FilterRuleAssociation.ValueString() == "managed" {
+		staleFilterRuleId = state.FilterRuleId.ValueString()
+	}
+
+	createdFilterRuleId := ""
+	data.FilterRuleId = types.StringValue("")
+	if data.FilterRuleAssociation.ValueString() == "managed" {
+		filterRule := natPortForwardFilterRule(data)
+
+		if staleFilterRuleId != "" {
+			err := r.client.Firewall().UpdateFilter(ctx, staleFilterRuleId, filterRule)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error",
+					fmt.Sprintf("Unable to update firewall nat port forward filter rule, got error: %s", err))
+				return
+			}
+			data.FilterRuleId = types.StringValue(staleFilterRuleId)
+			staleFilterRuleId = ""
+		} else {
+			filterRuleId, err := r.client.Firewall().AddFilter(ctx, filterRule)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error",
+					fmt.Sprintf("Unable to create firewall nat port forward filter rule, got error: %s", err))
+				return
+			}
+			data.FilterRuleId = types.StringValue(filterRuleId)
+			createdFilterRuleId = filterRuleId
+		}
+	}
+
 	// Convert TF schema OPNsense struct
 	portForward, err := convertNATPortForwardSchemaToStruct(data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("Unable to parse firewall nat port forward, got error: %s", err))
+		r.deleteCreatedFilterRule(ctx, createdFilterRuleId, resp)
 		return
 	}
 
@@ -170,13 +247,36 @@ func (r *natPortForwardResource) Update(ctx context.Context, req resource.Update
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("Unable to update firewall nat port forward, got error: %s", err))
+		r.deleteCreatedFilterRule(ctx, createdFilterRuleId, resp)
 		return
 	}
 
+	// Remove the filter rule that is no longer linked to the port forward
+	if staleFilterRuleId != "" {
+		err = r.client.Firewall().DeleteFilter(ctx, staleFilterRuleId)
+		var notFoundError *errs.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to delete firewall nat port forward filter rule, got error: %s", err))
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// deleteCreatedFilterRule removes a linked filter rule that was created by a
+// failed update, as it is not tracked in state.
+func (r *natPortForwardResource) deleteCreatedFilterRule(ctx context.Context, filterRuleId string, resp *resource.UpdateResponse) {
+	if filterRuleId == "" {
+		return
+	}
+	if err := r.client.Firewall().DeleteFilter(ctx, filterRuleId); err != nil {
+		resp.Diagnostics.AddWarning("Client Error",
+			fmt.Sprintf("Unable to remove firewall nat port forward filter rule %s, got error: %s", filterRuleId, err))
+	}
+}
+
 func (r *natPortForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *natPortForwardResourceModel
 
@@ -194,6 +294,51 @@ func (r *natPortForwardResource) Delete(ctx context.Context, req resource.Delete
 			fmt.Sprintf("Unable to delete firewall nat port forward, got error: %s", err))
 		return
 	}
+
+	// Delete the linked filter rule
+	if data.FilterRuleAssociation.ValueString() == "managed" && data.FilterRuleId.ValueString() != "" {
+		err = r.client.Firewall().DeleteFilter(ctx, data.FilterRuleId.ValueString())
+		var notFoundError *errs.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to delete firewall nat port forward filter rule, got error: %s", err))
+			return
+		}
+	}
+}
+
+func (r *natPortForwardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	refs := newRuleReferences(r.client, r.references, req.Plan)
+	refs.ValidateNet(ctx, path.Root("source").AtName("net"), &resp.Diagnostics)
+	refs.ValidatePort(ctx, path.Root("source").AtName("port"), &resp.Diagnostics)
+	refs.ValidateNet(ctx, path.Root("destination").AtName("net"), &resp.Diagnostics)
+	refs.ValidatePort(ctx, path.Root("destination").AtName("port"), &resp.Diagnostics)
+	refs.ValidateNet(ctx, path.Root("target").AtName("ip"), &resp.Diagnostics)
+	refs.ValidatePort(ctx, path.Root("target").AtName("port"), &resp.Diagnostics)
+
+	// filter_rule_id only keeps its prior value while the port forward stays
+	// managed; otherwise a rule is created or the link is removed
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var planAssociation, stateAssociation types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("filter_rule_association"), &planAssociation)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("filter_rule_association"), &stateAssociation)...)
+	if resp.Diagnostics.HasError() || planAssociation.Equal(stateAssociation) {
+		return
+	}
+
+	filterRuleId := types.StringValue("")
+	if planAssociation.IsUnknown() || planAssociation.ValueString() == "managed" {
+		filterRuleId = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filter_rule_id"), filterRuleId)...)
 }
 
 func (r *natPortForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -232,7 +377,11 @@ func upgradeNATPortForwardStateV1toV2(ctx context.Context, req resource.UpgradeS
 		Log:           oldState.Log,
 		NatReflection: oldState.NatReflection,
 		Description:   oldState.Description,
-		Id:            oldState.Id,
+
+		FilterRuleAssociation: types.StringValue("none"),
+		FilterRuleId:          types.StringValue(""),
+
+		Id: oldState.Id,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
@@ -189,3 +189,86 @@ resource "opnsense_firewall_nat_port_forward" "test" {
 		natReflection, description,
 	)
 }
+
+// TestAccFirewallNatPortForwardFilterRuleAssociationResource moves a port
+// forward through every filter rule association, checking that the linked
+// filter rule is created, kept, and removed.
+func TestAccFirewallNatPortForwardFilterRuleAssociationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a managed filter rule
+			{
+				Config: testAccFirewallNatPortForwardFilterRuleAssociationConfig("managed", "8443"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_port_forward.test", "filter_rule_association", "managed"),
+					resource.TestCheckResourceAttrSet("opnsense_firewall_nat_port_forward.test", "filter_rule_id"),
+					resource.TestCheckResourceAttrPair("opnsense_firewall_nat_port_forward.test", "filter_rule_id", "data.opnsense_firewall_filter.test", "id"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_filter.test", "filter.action", "pass"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_filter.test", "filter.destination.net", "192.168.10.40"),
+					resource.TestCheckResourceAttr("data.opnsense_firewall_filter.test", "filter.destination.port", "443"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_nat_port_forward.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the port forward, the linked filter rule follows
+			{
+				Config: testAccFirewallNatPortForwardFilterRuleAssociationConfig("managed", "8444"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_port_forward.test", "destination.port", "8444"),
+					resource.TestCheckResourceAttrPair("opnsense_firewall_nat_port_forward.test", "filter_rule_id", "data.opnsense_firewall_filter.test", "id"),
+				),
+			},
+			// Switch to pass, removing the linked filter rule
+			{
+				Config: testAccFirewallNatPortForwardFilterRuleAssociationConfig("pass", "8444"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_port_forward.test", "filter_rule_association", "pass"),
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_port_forward.test", "filter_rule_id", ""),
+				),
+			},
+			// Switch to none
+			{
+				Config: testAccFirewallNatPortForwardFilterRuleAssociationConfig("none", "8444"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_port_forward.test", "filter_rule_association", "none"),
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_port_forward.test", "filter_rule_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallNatPortForwardFilterRuleAssociationConfig(association, destPort string) string {
+	config := fmt.Sprintf(`
+resource "opnsense_firewall_nat_port_forward" "test" {
+  interface = ["wan"]
+  protocol  = "tcp"
+  destination = {
+    net  = "wanip"
+    port = %[2]q
+  }
+  target = {
+    ip   = "192.168.10.40"
+    port = "443"
+  }
+  filter_rule_association = %[1]q
+  description             = "Filter rule association"
+}
+`, association, destPort)
+
+	if association == "managed" {
+		config += `
+data "opnsense_firewall_filter" "test" {
+  id = opnsense_firewall_nat_port_forward.test.filter_rule_id
+}
+`
+	}
+
+	return config
+}
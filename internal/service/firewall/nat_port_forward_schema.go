@@ -42,6 +42,9 @@ type natPortForwardResourceModel struct {
 	NatReflection types.String `tfsdk:"nat_reflection"`
 	Description   types.String `tfsdk:"description"`
 
+	FilterRuleAssociation types.String `tfsdk:"filter_rule_association"`
+	FilterRuleId          types.String `tfsdk:"filter_rule_id"`
+
 	Id types.String `tfsdk:"id"`
 }
 
@@ -123,7 +126,7 @@ func natPortForwardResourceSchema() schema.Schema {
 				),
 				Attributes: map[string]schema.Attribute{
 					"net": schema.StringAttribute{
-						MarkdownDescription: "Specify the IP address, CIDR or alias for the source of the packet. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Defaults to `any`.",
+						MarkdownDescription: "Specify the IP address, CIDR or alias for the source of the packet. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Names that are not an existing alias or interface produce a warning at plan time. Defaults to `any`.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString("any"),
@@ -165,7 +168,7 @@ func natPortForwardResourceSchema() schema.Schema {
 				),
 				Attributes: map[string]schema.Attribute{
 					"net": schema.StringAttribute{
-						MarkdownDescription: "Specify the IP address, CIDR or alias for the destination of the packet. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Defaults to `any`.",
+						MarkdownDescription: "Specify the IP address, CIDR or alias for the destination of the packet. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Names that are not an existing alias or interface produce a warning at plan time. Defaults to `any`.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString("any"),
@@ -233,6 +236,22 @@ func natPortForwardResourceSchema() schema.Schema {
 					stringvalidator.LengthBetween(1, 255),
 				},
 			},
+			"filter_rule_association": schema.StringAttribute{
+				MarkdownDescription: "Filter rule association for this port forward. One of `none`, `managed`, or `pass`. `managed` creates a linked pass rule for the forwarded traffic and keeps it in sync with this entry; `pass` passes the forwarded traffic without a separate filter rule. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "managed", "pass"),
+				},
+			},
+			"filter_rule_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the linked filter rule when `filter_rule_association` is `managed`, otherwise empty.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "UUID of the resource.",
@@ -251,6 +270,8 @@ func natPortForwardResourceSchemaV1() schema.Schema {
 		MarkdownDescription: "Choose on which interface packets must come in to match this rule.",
 		Required:            true,
 	}
+	delete(s.Attributes, "filter_rule_association")
+	delete(s.Attributes, "filter_rule_id")
 	return s
 }
 
@@ -343,6 +364,14 @@ func natPortForwardDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Optional description for reference.",
 				Computed:            true,
 			},
+			"filter_rule_association": dschema.StringAttribute{
+				MarkdownDescription: "Filter rule association for this port forward. One of `none`, `managed`, or `pass`.",
+				Computed:            true,
+			},
+			"filter_rule_id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the linked filter rule when `filter_rule_association` is `managed`, otherwise empty.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -373,6 +402,75 @@ func natReflectionAPIToSchema(s string) string {
 	}
 }
 
+// natFilterRuleAssociationToAPI converts filter_rule_association and
+// filter_rule_id to the OPNsense "associated rule" value: empty for no
+// association, "pass" to pass traffic without a filter rule, or the UUID of
+// the linked filter rule.
+func natFilterRuleAssociationToAPI(association string, filterRuleId string) string {
+	switch association {
+	case "pass":
+		return "pass"
+	case "managed":
+		return filterRuleId
+	default:
+		return ""
+	}
+}
+
+// natFilterRuleAssociationFromAPI converts the OPNsense "associated rule"
+// value back to filter_rule_association and filter_rule_id.
+func natFilterRuleAssociationFromAPI(s string) (string, string) {
+	switch s {
+	case "":
+		return "none", ""
+	case "pass":
+		return "pass", ""
+	default:
+		return "managed", s
+	}
+}
+
+// natPortForwardFilterRule builds the filter rule linked to a port forward
+// with filter_rule_association = "managed". The rule passes traffic from the
+// port forward's source to its target, like the rule OPNsense adds when
+// "Filter rule association" is set to "Add associated filter rule".
+func natPortForwardFilterRule(d *natPortForwardResourceModel) *firewall.Filter {
+	rule := &firewall.Filter{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Sequence:    tools.Int64ToString(d.Sequence.ValueInt64()),
+		Interface:   natPortForwardInterfaceSchemaToAPI(d.Interface),
+		Quick:       "1",
+		Action:      api.SelectedMap("pass"),
+		Direction:   api.SelectedMap("in"),
+		IPProtocol:  api.SelectedMap(d.IPProtocol.ValueString()),
+		Protocol:    api.SelectedMap(strings.ToUpper(d.Protocol.ValueString())),
+		Log:         tools.BoolToString(d.Log.ValueBool()),
+		Description: "NAT " + d.Description.ValueString(),
+	}
+
+	if d.Description.ValueString() == "" {
+		rule.Description = "NAT port forward"
+	}
+
+	if d.Source != nil {
+		rule.SourceNet = d.Source.Net.ValueString()
+		rule.SourcePort = d.Source.Port.ValueString()
+		rule.SourceInvert = tools.BoolToString(d.Source.Invert.ValueBool())
+	}
+
+	if d.Target != nil {
+		rule.DestinationNet = d.Target.IP.ValueString()
+		rule.DestinationPort = d.Target.Port.ValueString()
+	}
+
+	// Without a target port, traffic is forwarded to the original port
+	if rule.DestinationPort == "" && d.Destination != nil {
+		rule.DestinationPort = d.Destination.Port.ValueString()
+	}
+
+	return rule
+}
+
 func natPortForwardInterfaceSchemaToAPI(s types.Set) api.SelectedMapList {
 	var interfaces []string
 	s.ElementsAs(context.Background(), &interfaces, false)
@@ -428,6 +526,10 @@ func convertNATPortForwardSchemaToStruct(d *natPortForwardResourceModel) (*firew
 		Log:           tools.BoolToString(d.Log.ValueBool()),
 		NatReflection: api.SelectedMap(natReflectionSchemaToAPI(d.NatReflection.ValueString())),
 		Description:   d.Description.ValueString(),
+		AssociatedRuleId: natFilterRuleAssociationToAPI(
+			d.FilterRuleAssociation.ValueString(),
+			d.FilterRuleId.ValueString(),
+		),
 	}, nil
 }
 
@@ -441,6 +543,8 @@ func convertNATPortForwardStructToSchema(d *firewall.NatPortForward) (*natPortFo
 		destinationNet = "any"
 	}
 
+	association, filterRuleId := natFilterRuleAssociationFromAPI(d.AssociatedRuleId)
+
 	return &natPortForwardResourceModel{
 		// API uses "disabled" (inverted), schema uses "enabled" (user-friendly).
 		Enabled:    types.BoolValue(!tools.StringToBool(d.Disabled)),
@@ -465,5 +569,8 @@ func convertNATPortForwardStructToSchema(d *firewall.NatPortForward) (*natPortFo
 		Log:           types.BoolValue(tools.StringToBool(d.Log)),
 		NatReflection: types.StringValue(natReflectionAPIToSchema(d.NatReflection.String())),
 		Description:   tools.StringOrNull(d.Description),
+
+		FilterRuleAssociation: types.StringValue(association),
+		FilterRuleId:          types.StringValue(filterRuleId),
 	}, nil
 }
@@ -169,3 +169,63 @@ func TestNatPortForwardInterfaceStringToSet(t *testing.T) {
 
 	require.ElementsMatch(t, []string{"wan", "openvpn", "lan"}, tools.SetToStringSlice(result))
 }
+
+func TestNatFilterRuleAssociation(t *testing.T) {
+	tests := []struct {
+		association  string
+		filterRuleId string
+		api          string
+	}{
+		{"none", "", ""},
+		{"pass", "", "pass"},
+		{"managed", "2c1ac6a5-3a6c-4e0b-9b8f-6a4b9c1d2e3f", "2c1ac6a5-3a6c-4e0b-9b8f-6a4b9c1d2e3f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.association, func(t *testing.T) {
+			require.Equal(t, tt.api, natFilterRuleAssociationToAPI(tt.association, tt.filterRuleId))
+
+			association, filterRuleId := natFilterRuleAssociationFromAPI(tt.api)
+			require.Equal(t, tt.association, association)
+			require.Equal(t, tt.filterRuleId, filterRuleId)
+		})
+	}
+}
+
+func TestNatPortForwardFilterRule(t *testing.T) {
+	rule := natPortForwardFilterRule(&natPortForwardResourceModel{
+		Enabled:  types.BoolValue(true),
+		Sequence: types.Int64Value(10),
+		Interface: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("wan"),
+		}),
+		IPProtocol: types.StringValue("inet"),
+		Protocol:   types.StringValue("tcp/udp"),
+		Source: &firewallLocation{
+			Net:    types.StringValue("203.0.113.0/24"),
+			Port:   types.StringValue(""),
+			Invert: types.BoolValue(false),
+		},
+		Destination: &firewallLocation{
+			Net:    types.StringValue("wanip"),
+			Port:   types.StringValue("2222"),
+			Invert: types.BoolValue(false),
+		},
+		Target: &firewallTarget{
+			IP:   types.StringValue("10.1.1.10"),
+			Port: types.StringValue(""),
+		},
+		Log:         types.BoolValue(false),
+		Description: types.StringValue("SSH"),
+	})
+
+	require.Equal(t, "pass", rule.Action.String())
+	require.Equal(t, "in", rule.Direction.String())
+	require.Equal(t, "TCP/UDP", rule.Protocol.String())
+	require.Equal(t, "wan", rule.Interface.String())
+	require.Equal(t, "203.0.113.0/24", rule.SourceNet)
+	require.Equal(t, "10.1.1.10", rule.DestinationNet)
+	// No target port, so the rule matches the original destination port
+	require.Equal(t, "2222", rule.DestinationPort)
+	require.Equal(t, "NAT SSH", rule.Description)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
 	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/cache"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +19,7 @@ import (
 var _ resource.Resource = &natResource{}
 var _ resource.ResourceWithConfigure = &natResource{}
 var _ resource.ResourceWithImportState = &natResource{}
+var _ resource.ResourceWithModifyPlan = &natResource{}
 
 func newNATResource() resource.Resource {
 	return &natResource{}
@@ -25,7 +27,8 @@ func newNATResource() resource.Resource {
 
 // natResource defines the resource implementation.
 type natResource struct {
-	client opnsense.Client
+	client     opnsense.Client
+	references *cache.Cache
 }
 
 func (r *natResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +55,7 @@ func (r *natResource) Configure(ctx context.Context, req resource.ConfigureReque
 	}
 
 	r.client = opnsense.NewClient(apiClient)
+	r.references = cache.ForClient(apiClient)
 }
 
 func (r *natResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -187,6 +191,21 @@ func (r *natResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 }
 
+func (r *natResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	refs := newRuleReferences(r.client, r.references, req.Plan)
+	refs.ValidateNet(ctx, path.Root("source").AtName("net"), &resp.Diagnostics)
+	refs.ValidatePort(ctx, path.Root("source").AtName("port"), &resp.Diagnostics)
+	refs.ValidateNet(ctx, path.Root("destination").AtName("net"), &resp.Diagnostics)
+	refs.ValidatePort(ctx, path.Root("destination").AtName("port"), &resp.Diagnostics)
+	refs.ValidateNet(ctx, path.Root("target").AtName("ip"), &resp.Diagnostics)
+	refs.ValidatePort(ctx, path.Root("target").AtName("port"), &resp.Diagnostics)
+}
+
 func (r *natResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
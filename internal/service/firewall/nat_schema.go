@@ -105,7 +105,7 @@ func natResourceSchema() schema.Schema {
 				),
 				Attributes: map[string]schema.Attribute{
 					"net": schema.StringAttribute{
-						MarkdownDescription: "Specify the IP address, CIDR or alias for the source of the packet for this mapping. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Defaults to `any`.",
+						MarkdownDescription: "Specify the IP address, CIDR or alias for the source of the packet for this mapping. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Names that are not an existing alias or interface produce a warning at plan time. Defaults to `any`.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString("any"),
@@ -147,7 +147,7 @@ func natResourceSchema() schema.Schema {
 				),
 				Attributes: map[string]schema.Attribute{
 					"net": schema.StringAttribute{
-						MarkdownDescription: "Specify the IP address, CIDR or alias for the destination of the packet for this mapping. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Defaults to `any`.",
+						MarkdownDescription: "Specify the IP address, CIDR or alias for the destination of the packet for this mapping. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Names that are not an existing alias or interface produce a warning at plan time. Defaults to `any`.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString("any"),
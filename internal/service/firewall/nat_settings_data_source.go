@@ -0,0 +1,77 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &natSettingsDataSource{}
+var _ datasource.DataSourceWithConfigure = &natSettingsDataSource{}
+
+func newNATSettingsDataSource() datasource.DataSource {
+	return &natSettingsDataSource{}
+}
+
+type natSettingsDataSource struct {
+	client opnsense.Client
+}
+
+func (d *natSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_nat_settings"
+}
+
+func (d *natSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = natSettingsDataSourceSchema()
+}
+
+func (d *natSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *natSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *natSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Firewall().NATSettingsGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall nat settings, got error: %s", err))
+		return
+	}
+
+	resourceModel, err := convertNATSettingsStructToSchema(&result.NAT)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse firewall nat settings, got error: %s", err))
+		return
+	}
+
+	resourceModel.Id = types.StringValue("firewall_nat_settings")
+
+	tflog.Trace(ctx, "read firewall nat settings data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
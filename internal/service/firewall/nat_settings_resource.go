@@ -0,0 +1,104 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &natSettingsResource{}
+var _ resource.ResourceWithConfigure = &natSettingsResource{}
+var _ resource.ResourceWithImportState = &natSettingsResource{}
+
+func newNATSettingsResource() resource.Resource {
+	return &natSettingsResource{singletonResource{typeName: "firewall_nat_settings", description: "NAT"}}
+}
+
+type natSettingsResource struct {
+	singletonResource
+}
+
+func (r *natSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = natSettingsResourceSchema()
+}
+
+func (r *natSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *natSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Firewall().NATSettingsGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall nat settings, got error: %s", err))
+		return
+	}
+
+	resourceModel, err := convertNATSettingsStructToSchema(&result.NAT)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse firewall nat settings, got error: %s", err))
+		return
+	}
+
+	resourceModel.Id = data.Id
+
+	tflog.Trace(ctx, "read firewall nat settings resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
+
+func (r *natSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *natSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceStruct, err := convertNATSettingsSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse firewall nat settings, got error: %s", err))
+		return
+	}
+
+	_, err = r.client.Firewall().NATSettingsSet(ctx, resourceStruct)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update firewall nat settings, got error: %s", err))
+		return
+	}
+
+	_, err = r.client.Firewall().NATSettingsReconfigure(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to reconfigure firewall nat settings, got error: %s", err))
+		return
+	}
+
+	result, err := r.client.Firewall().NATSettingsGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read updated firewall nat settings, got error: %s", err))
+		return
+	}
+
+	resourceModel, err := convertNATSettingsStructToSchema(&result.NAT)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse updated firewall nat settings, got error: %s", err))
+		return
+	}
+
+	resourceModel.Id = data.Id
+
+	tflog.Trace(ctx, "updated firewall nat settings resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
@@ -0,0 +1,82 @@
+package firewall_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFirewallNATSettingsResource tests the singleton NAT settings resource.
+// Because this resource blocks creation, the test begins with an import step.
+func TestAccFirewallNATSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccFirewallNATSettingsResourceConfig("automatic", false),
+				ResourceName:       "opnsense_firewall_nat_settings.test",
+				ImportState:        true,
+				ImportStateId:      "firewall_nat_settings",
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccFirewallNATSettingsResourceConfig("automatic", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_settings.test", "id", "firewall_nat_settings"),
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_settings.test", "mode", "automatic"),
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_settings.test", "reflection_port_forward", "false"),
+				),
+			},
+			{
+				Config: testAccFirewallNATSettingsResourceConfig("hybrid", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_settings.test", "mode", "hybrid"),
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_settings.test", "reflection_port_forward", "true"),
+				),
+			},
+			{
+				Config: testAccFirewallNATSettingsResourceConfig("manual", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_settings.test", "mode", "manual"),
+				),
+			},
+			// Restore original state
+			{
+				Config: testAccFirewallNATSettingsResourceConfig("automatic", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_settings.test", "mode", "automatic"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccFirewallNATSettingsResource_CreateBlocked verifies that attempting to
+// create this singleton resource without importing it first returns a clear error.
+func TestAccFirewallNATSettingsResource_CreateBlocked(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      `resource "opnsense_firewall_nat_settings" "test" {}`,
+				ExpectError: regexp.MustCompile("Cannot Create Singleton Resource"),
+			},
+		},
+	})
+}
+
+func testAccFirewallNATSettingsResourceConfig(mode string, reflectionPortForward bool) string {
+	return fmt.Sprintf(`
+resource "opnsense_firewall_nat_settings" "test" {
+  mode                     = %[1]q
+  reflection_port_forward  = %[2]t
+  reflection_one_to_one    = false
+  reflection_auto_outbound = false
+}
+`, mode, reflectionPortForward)
+}
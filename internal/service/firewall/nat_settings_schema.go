@@ -0,0 +1,135 @@
+package firewall
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// natSettingsResourceModel describes the resource data model.
+// This is a SINGLETON resource — it manages existing upstream configuration
+// that cannot be created or destroyed via Terraform.
+type natSettingsResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Mode                   types.String `tfsdk:"mode"`
+	ReflectionPortForward  types.Bool   `tfsdk:"reflection_port_forward"`
+	ReflectionOneToOne     types.Bool   `tfsdk:"reflection_one_to_one"`
+	ReflectionAutoOutbound types.Bool   `tfsdk:"reflection_auto_outbound"`
+}
+
+func natSettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manages the outbound NAT mode and global NAT reflection settings. `opnsense_firewall_nat` rules only take effect when `mode` is `hybrid` or `manual`. This is a singleton resource that manages existing upstream configuration.\n\n" +
+			"**Important:** This resource must be imported before it can be managed:\n" +
+			"```bash\n" +
+			"terraform import opnsense_firewall_nat_settings.settings firewall_nat_settings\n" +
+			"```\n\n" +
+			"After importing, you can manage the configuration with `terraform apply`. " +
+			"Running `terraform destroy` will remove the resource from state but will NOT modify the upstream configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always set to `firewall_nat_settings`. Use this value when importing: `terraform import opnsense_firewall_nat_settings.settings firewall_nat_settings`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Outbound NAT mode. One of `automatic` (automatically generated rules only), `hybrid` (automatically generated rules plus manual rules), `manual` (manual rules only) or `disabled` (no outbound NAT). Defaults to `automatic`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("automatic"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("automatic", "hybrid", "manual", "disabled"),
+				},
+			},
+			"reflection_port_forward": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable NAT reflection for port forwards by default. Individual port forward rules can override this with their `nat_reflection` attribute. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"reflection_one_to_one": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable NAT reflection for 1:1 mappings by default. Individual 1:1 rules can override this with their `nat_reflection` attribute. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"reflection_auto_outbound": schema.BoolAttribute{
+				MarkdownDescription: "Whether to automatically create outbound NAT rules that direct traffic back out to the same subnet it originated from, which is required for NAT reflection to work when the client and server are on the same subnet. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func natSettingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Reads the outbound NAT mode and global NAT reflection settings from the upstream system.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always set to `firewall_nat_settings`.",
+			},
+			"mode": dschema.StringAttribute{
+				MarkdownDescription: "Outbound NAT mode. One of `automatic`, `hybrid`, `manual` or `disabled`.",
+				Computed:            true,
+			},
+			"reflection_port_forward": dschema.BoolAttribute{
+				MarkdownDescription: "Whether NAT reflection is enabled for port forwards by default.",
+				Computed:            true,
+			},
+			"reflection_one_to_one": dschema.BoolAttribute{
+				MarkdownDescription: "Whether NAT reflection is enabled for 1:1 mappings by default.",
+				Computed:            true,
+			},
+			"reflection_auto_outbound": dschema.BoolAttribute{
+				MarkdownDescription: "Whether outbound NAT rules for NAT reflection are created automatically.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertNATSettingsSchemaToStruct(d *natSettingsResourceModel) (*firewall.NATSettings, error) {
+	// map Terraform "manual" → API "advanced"
+	mode := d.Mode.ValueString()
+	if mode == "manual" {
+		mode = "advanced"
+	}
+
+	return &firewall.NATSettings{
+		OutboundMode:           api.SelectedMap(mode),
+		ReflectionPortForward:  tools.BoolToString(d.ReflectionPortForward.ValueBool()),
+		ReflectionOneToOne:     tools.BoolToString(d.ReflectionOneToOne.ValueBool()),
+		ReflectionAutoOutbound: tools.BoolToString(d.ReflectionAutoOutbound.ValueBool()),
+	}, nil
+}
+
+func convertNATSettingsStructToSchema(d *firewall.NATSettings) (*natSettingsResourceModel, error) {
+	// map API "advanced" → Terraform "manual"
+	mode := d.OutboundMode.String()
+	if mode == "advanced" {
+		mode = "manual"
+	}
+
+	return &natSettingsResourceModel{
+		Mode:                   types.StringValue(mode),
+		ReflectionPortForward:  types.BoolValue(tools.StringToBool(d.ReflectionPortForward)),
+		ReflectionOneToOne:     types.BoolValue(tools.StringToBool(d.ReflectionOneToOne)),
+		ReflectionAutoOutbound: types.BoolValue(tools.StringToBool(d.ReflectionAutoOutbound)),
+	}, nil
+}
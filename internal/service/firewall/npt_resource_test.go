@@ -0,0 +1,77 @@
+package firewall_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallNPTResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallNPTResourceConfig(true, false, "fd00:10::/64", "2001:db8:10::/64", "Testing NPTv6"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "log", "false"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "interface", "wan"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "internal_prefix", "fd00:10::/64"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "external_prefix", "2001:db8:10::/64"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "track_interface", ""),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "description", "Testing NPTv6"),
+					resource.TestCheckResourceAttrSet("opnsense_firewall_npt.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_npt.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallNPTResourceConfig(false, true, "fd00:20::/56", "2001:db8:20::/56", "Updated NPTv6"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "log", "true"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "internal_prefix", "fd00:20::/56"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "external_prefix", "2001:db8:20::/56"),
+					resource.TestCheckResourceAttr("opnsense_firewall_npt.test", "description", "Updated NPTv6"),
+					resource.TestCheckResourceAttrSet("opnsense_firewall_npt.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFirewallNPTResource_PrefixLengthMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallNPTResourceConfig(true, false, "fd00:10::/64", "2001:db8:10::/48", "Mismatched NPTv6"),
+				ExpectError: regexp.MustCompile("prefix length"),
+			},
+		},
+	})
+}
+
+func testAccFirewallNPTResourceConfig(enabled, log bool, internalPrefix, externalPrefix, description string) string {
+	return fmt.Sprintf(`
+resource "opnsense_firewall_npt" "test" {
+  enabled         = %[1]t
+  log             = %[2]t
+  internal_prefix = %[3]q
+  external_prefix = %[4]q
+  description     = %[5]q
+}
+`, enabled, log, internalPrefix, externalPrefix, description)
+}
@@ -0,0 +1,210 @@
+package firewall
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var ipv6PrefixValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^([0-9a-fA-F:]+)\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8])$`),
+	"must be a valid IPv6 prefix (e.g. fd00:1::/64, 2001:db8::/48)",
+)
+
+// nptResourceModel describes the resource data model.
+type nptResourceModel struct {
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Log            types.Bool   `tfsdk:"log"`
+	Sequence       types.Int64  `tfsdk:"sequence"`
+	Interface      types.String `tfsdk:"interface"`
+	InternalPrefix types.String `tfsdk:"internal_prefix"`
+	ExternalPrefix types.String `tfsdk:"external_prefix"`
+	TrackInterface types.String `tfsdk:"track_interface"`
+	Categories     types.Set    `tfsdk:"categories"`
+	Description    types.String `tfsdk:"description"`
+	Id             types.String `tfsdk:"id"`
+}
+
+func nptResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "IPv6 Network Prefix Translation (NPTv6) translates an internal IPv6 prefix to an external IPv6 prefix of the same size, and vice versa. It is stateless and does not rewrite ports, which makes it suitable for multi-homed IPv6 sites that use internal ULA or provider-independent addressing.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this NPTv6 rule. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"log": schema.BoolAttribute{
+				MarkdownDescription: "Log packets that are handled by this rule. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"sequence": schema.Int64Attribute{
+				MarkdownDescription: "Specify the order of this NPTv6 rule. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Choose which interface this rule applies to. Defaults to `wan`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("wan"),
+			},
+			"internal_prefix": schema.StringAttribute{
+				MarkdownDescription: "Enter the internal IPv6 prefix for the network prefix translation (e.g. `fd00:1::/64`). The prefix size specified here is also applied to the external prefix.",
+				Required:            true,
+				Validators: []validator.String{
+					ipv6PrefixValidator,
+				},
+			},
+			"external_prefix": schema.StringAttribute{
+				MarkdownDescription: "Enter the external IPv6 prefix for the network prefix translation. Must have the same prefix length as `internal_prefix`. Leave empty to auto-detect the prefix using `track_interface`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						ipv6PrefixValidator,
+					),
+				},
+			},
+			"track_interface": schema.StringAttribute{
+				MarkdownDescription: "Use the prefix defined on the selected interface instead of the interface this rule applies to when `external_prefix` is not provided. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"categories": schema.SetAttribute{
+				MarkdownDescription: "Set of category IDs to apply. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func nptDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "IPv6 Network Prefix Translation (NPTv6) translates an internal IPv6 prefix to an external IPv6 prefix of the same size, and vice versa. It is stateless and does not rewrite ports, which makes it suitable for multi-homed IPv6 sites that use internal ULA or provider-independent addressing.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Enable this NPTv6 rule.",
+				Computed:            true,
+			},
+			"log": dschema.BoolAttribute{
+				MarkdownDescription: "Log packets that are handled by this rule.",
+				Computed:            true,
+			},
+			"sequence": dschema.Int64Attribute{
+				MarkdownDescription: "The order of this NPTv6 rule.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface this rule applies to.",
+				Computed:            true,
+			},
+			"internal_prefix": dschema.StringAttribute{
+				MarkdownDescription: "The internal IPv6 prefix for the network prefix translation.",
+				Computed:            true,
+			},
+			"external_prefix": dschema.StringAttribute{
+				MarkdownDescription: "The external IPv6 prefix for the network prefix translation. Empty when the prefix is derived from `track_interface`.",
+				Computed:            true,
+			},
+			"track_interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface whose prefix is used when `external_prefix` is not provided.",
+				Computed:            true,
+			},
+			"categories": dschema.SetAttribute{
+				MarkdownDescription: "Set of category IDs to apply.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertNPTSchemaToStruct(d *nptResourceModel) (*firewall.NPT, error) {
+	// Parse 'Categories'
+	var categoriesList []string
+	d.Categories.ElementsAs(context.Background(), &categoriesList, false)
+
+	return &firewall.NPT{
+		Enabled:        tools.BoolToString(d.Enabled.ValueBool()),
+		Log:            tools.BoolToString(d.Log.ValueBool()),
+		Sequence:       tools.Int64ToString(d.Sequence.ValueInt64()),
+		Interface:      api.SelectedMap(d.Interface.ValueString()),
+		SourceNet:      d.InternalPrefix.ValueString(),
+		DestinationNet: d.ExternalPrefix.ValueString(),
+		TrackInterface: api.SelectedMap(d.TrackInterface.ValueString()),
+		Categories:     categoriesList,
+		Description:    d.Description.ValueString(),
+	}, nil
+}
+
+func convertNPTStructToSchema(d *firewall.NPT) (*nptResourceModel, error) {
+	model := &nptResourceModel{
+		Enabled:        types.BoolValue(tools.StringToBool(d.Enabled)),
+		Log:            types.BoolValue(tools.StringToBool(d.Log)),
+		Sequence:       tools.StringToInt64Null(d.Sequence),
+		Interface:      types.StringValue(d.Interface.String()),
+		InternalPrefix: types.StringValue(d.SourceNet),
+		ExternalPrefix: types.StringValue(d.DestinationNet),
+		TrackInterface: types.StringValue(d.TrackInterface.String()),
+		Categories:     types.SetNull(types.StringType),
+		Description:    tools.StringOrNull(d.Description),
+	}
+
+	// Parse 'Categories'
+	var categoriesList []attr.Value
+	for _, i := range d.Categories {
+		categoriesList = append(categoriesList, basetypes.NewStringValue(i))
+	}
+	categoriesTypeList, _ := types.SetValue(types.StringType, categoriesList)
+	model.Categories = categoriesTypeList
+
+	return model, nil
+}
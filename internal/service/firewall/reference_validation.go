@@ -0,0 +1,407 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/cache"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// wellKnownPorts are the service names OPNsense accepts in place of a port
+// number.
+var wellKnownPorts = map[string]uint16{
+	"afs3-fileserver": 7000,
+	"aol":             5190,
+	"auth":            113,
+	"avt-profile-1":   5004,
+	"cvsup":           5999,
+	"domain":          53,
+	"ftp":             21,
+	"hbci":            3000,
+	"http":            80,
+	"https":           443,
+	"igmpv3lite":      465,
+	"imap":            143,
+	"imaps":           993,
+	"ipsec-msft":      4500,
+	"isakmp":          500,
+	"l2f":             1701,
+	"ldap":            389,
+	"microsoft-ds":    445,
+	"ms-streaming":    1755,
+	"ms-wbt-server":   3389,
+	"msnp":            1863,
+	"nat-stun-port":   3478,
+	"netbios-dgm":     138,
+	"netbios-ns":      137,
+	"netbios-ssn":     139,
+	"nntp":            119,
+	"ntp":             123,
+	"openvpn":         1194,
+	"pop3":            110,
+	"pop3s":           995,
+	"pptp":            1723,
+	"radius":          1812,
+	"radius-acct":     1813,
+	"rfb":             5900,
+	"sip":             5060,
+	"smtp":            25,
+	"snmp":            161,
+	"snmptrap":        162,
+	"ssh":             22,
+	"submission":      587,
+	"telnet":          23,
+	"teredo":          3544,
+	"tftp":            69,
+	"urd":             465,
+	"wins":            1512,
+}
+
+var (
+	portLiteralRegex     = regexp.MustCompile(`^\d+(-\d+)?$`)
+	interfaceMacroRegex  = regexp.MustCompile(`^__(\w+?)_(network|address)$`)
+	interfaceSuffixRegex = regexp.MustCompile(`^(\w+?)ip$`)
+)
+
+// ruleReferences checks the names a rule refers to (aliases, gateways,
+// categories, schedules and interface macros) against the live OPNsense
+// configuration. The lists are shared between rules through the cache of the
+// provider instance, and fetched again before a reference is reported as
+// unknown.
+//
+// References by name (aliases, gateways, schedules) only produce warnings,
+// since the referenced object may be created in the same apply and its name
+// is already known at plan time. References by UUID (categories) produce
+// errors, since the UUID of an object created in the same apply is unknown
+// at plan time and therefore never checked.
+type ruleReferences struct {
+	client opnsense.Client
+	lists  *cache.Cache
+	plan   tfsdk.Plan
+}
+
+func newRuleReferences(client opnsense.Client, lists *cache.Cache, plan tfsdk.Plan) *ruleReferences {
+	return &ruleReferences{client: client, lists: lists, plan: plan}
+}
+
+// planString reads a string attribute from the plan, returning "" if it is
+// null, unknown or missing.
+func (v *ruleReferences) planString(ctx context.Context, p path.Path) string {
+	var value types.String
+	if diags := v.plan.GetAttribute(ctx, p, &value); diags.HasError() {
+		return ""
+	}
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return value.ValueString()
+}
+
+// contains returns the list of kind, and whether it contains value. If the
+// cached list does not contain value, the list is fetched again, so objects
+// created since the list was cached are found.
+func (v *ruleReferences) contains(ctx context.Context, list func(context.Context, bool) ([]string, error), value string) ([]string, bool, error) {
+	values, err := list(ctx, false)
+	if err != nil {
+		return nil, false, err
+	}
+	if slices.Contains(values, value) {
+		return values, true, nil
+	}
+
+	values, err = list(ctx, true)
+	if err != nil {
+		return nil, false, err
+	}
+	return values, slices.Contains(values, value), nil
+}
+
+func (v *ruleReferences) listAliases(ctx context.Context, refresh bool) ([]string, error) {
+	return cache.Get(v.lists, "firewall/aliases", refresh, func() ([]string, error) {
+		aliases, err := v.client.Firewall().ListAliases(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names := []string{}
+		for _, a := range aliases {
+			names = append(names, a.Name)
+		}
+		return names, nil
+	})
+}
+
+func (v *ruleReferences) listGateways(ctx context.Context, refresh bool) ([]string, error) {
+	return cache.Get(v.lists, "firewall/gateways", refresh, func() ([]string, error) {
+		gateways, err := v.client.Routing().ListGateways(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names := []string{}
+		for _, g := range gateways {
+			names = append(names, g.Name)
+		}
+
+		// Gateway groups are referenced by name the same way
+		groups, err := v.client.Routing().ListGatewayGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			names = append(names, g.Name)
+		}
+		return names, nil
+	})
+}
+
+func (v *ruleReferences) listCategories(ctx context.Context, refresh bool) ([]string, error) {
+	return cache.Get(v.lists, "firewall/categories", refresh, func() ([]string, error) {
+		categories, err := v.client.Firewall().ListCategories(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := []string{}
+		for id := range categories {
+			ids = append(ids, id)
+		}
+		return ids, nil
+	})
+}
+
+func (v *ruleReferences) listSchedules(ctx context.Context, refresh bool) ([]string, error) {
+	return cache.Get(v.lists, "firewall/schedules", refresh, func() ([]string, error) {
+		schedules, err := v.client.Firewall().ListSchedules(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names := []string{}
+		for _, s := range schedules {
+			names = append(names, s.Name)
+		}
+		return names, nil
+	})
+}
+
+func (v *ruleReferences) listInterfaces(ctx context.Context, refresh bool) ([]string, error) {
+	return cache.Get(v.lists, "firewall/interfaces", refresh, func() ([]string, error) {
+		result, err := v.client.Interfaces().OverviewGet(ctx)
+		if err != nil {
+			return nil, err
+		}
+		identifiers := []string{}
+		for _, i := range result.Rows {
+			if i.Identifier != "" {
+				identifiers = append(identifiers, i.Identifier)
+			}
+		}
+		return identifiers, nil
+	})
+}
+
+// ValidateNet checks that a source/destination network refers to an existing
+// alias or interface, unless it is `any`, `(self)`, an IP address, a CIDR or
+// an IP range.
+func (v *ruleReferences) ValidateNet(ctx context.Context, p path.Path, diags *diag.Diagnostics) {
+	for _, value := range strings.Split(v.planString(ctx, p), ",") {
+		value = strings.TrimSpace(value)
+		if isNetLiteral(value) {
+			continue
+		}
+
+		// Interface macros: `<if>`, `<if>ip`, `__<if>_network`, `__<if>_address`
+		interfaces, found, err := v.contains(ctx, v.listInterfaces, interfaceFromNetMacro(value))
+		if err != nil {
+			addReferenceListWarning(diags, "interfaces", err)
+			return
+		}
+		if found {
+			continue
+		}
+
+		aliases, found, err := v.contains(ctx, v.listAliases, value)
+		if err != nil {
+			addReferenceListWarning(diags, "aliases", err)
+			return
+		}
+		if found {
+			continue
+		}
+
+		diags.AddAttributeWarning(p, "Unknown Firewall Alias",
+			fmt.Sprintf("%q is not an IP address, network, interface or existing alias. This is expected if the alias is created in the same apply.%s",
+				value, suggestion(value, append(slices.Clone(aliases), interfaces...))))
+	}
+}
+
+// ValidatePort checks that a port refers to an existing alias, unless it is
+// a port number, a port range or a well known service name.
+func (v *ruleReferences) ValidatePort(ctx context.Context, p path.Path, diags *diag.Diagnostics) {
+	value := v.planString(ctx, p)
+	if _, ok := wellKnownPorts[value]; ok || value == "" || portLiteralRegex.MatchString(value) {
+		return
+	}
+
+	aliases, found, err := v.contains(ctx, v.listAliases, value)
+	if err != nil {
+		addReferenceListWarning(diags, "aliases", err)
+		return
+	}
+	if found {
+		return
+	}
+
+	diags.AddAttributeWarning(p, "Unknown Port Alias",
+		fmt.Sprintf("%q is not a port number, port range, well known service name or existing alias. This is expected if the alias is created in the same apply.%s",
+			value, suggestion(value, append(slices.Clone(aliases), slices.Collect(maps.Keys(wellKnownPorts))...))))
+}
+
+// ValidateGateway checks that a gateway (or gateway group) name exists.
+func (v *ruleReferences) ValidateGateway(ctx context.Context, p path.Path, diags *diag.Diagnostics) {
+	value := v.planString(ctx, p)
+	if value == "" {
+		return
+	}
+
+	gateways, found, err := v.contains(ctx, v.listGateways, value)
+	if err != nil {
+		addReferenceListWarning(diags, "gateways", err)
+		return
+	}
+	if found {
+		return
+	}
+
+	diags.AddAttributeWarning(p, "Unknown Gateway",
+		fmt.Sprintf("No gateway or gateway group named %q exists. This is expected if it is created in the same apply.%s", value, suggestion(value, gateways)))
+}
+
+// ValidateSchedule checks that a schedule name exists.
+func (v *ruleReferences) ValidateSchedule(ctx context.Context, p path.Path, diags *diag.Diagnostics) {
+	value := v.planString(ctx, p)
+	if value == "" {
+		return
+	}
+
+	schedules, found, err := v.contains(ctx, v.listSchedules, value)
+	if err != nil {
+		addReferenceListWarning(diags, "schedules", err)
+		return
+	}
+	if found {
+		return
+	}
+
+	diags.AddAttributeWarning(p, "Unknown Firewall Schedule",
+		fmt.Sprintf("No firewall schedule named %q exists. This is expected if it is created in the same apply.%s", value, suggestion(value, schedules)))
+}
+
+// ValidateCategories checks that every known category UUID exists.
+func (v *ruleReferences) ValidateCategories(ctx context.Context, p path.Path, diags *diag.Diagnostics) {
+	var set types.Set
+	if d := v.plan.GetAttribute(ctx, p, &set); d.HasError() || set.IsNull() || set.IsUnknown() {
+		return
+	}
+
+	var ids []types.String
+	set.ElementsAs(ctx, &ids, false)
+	for _, id := range ids {
+		if id.IsNull() || id.IsUnknown() {
+			continue
+		}
+
+		categories, found, err := v.contains(ctx, v.listCategories, id.ValueString())
+		if err != nil {
+			addReferenceListWarning(diags, "categories", err)
+			return
+		}
+		if found {
+			continue
+		}
+
+		diags.AddAttributeError(p, "Unknown Firewall Category",
+			fmt.Sprintf("No firewall category with ID %q exists.%s", id.ValueString(), suggestion(id.ValueString(), categories)))
+	}
+}
+
+func addReferenceListWarning(diags *diag.Diagnostics, kind string, err error) {
+	diags.AddWarning("Client Error",
+		fmt.Sprintf("Unable to list %s to validate references, got error: %s", kind, err))
+}
+
+// isNetLiteral returns true if value does not refer to any other object.
+func isNetLiteral(value string) bool {
+	if value == "" || value == "any" || value == "(self)" {
+		return true
+	}
+	if net.ParseIP(value) != nil {
+		return true
+	}
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return true
+	}
+	if from, to, found := strings.Cut(value, "-"); found {
+		return net.ParseIP(from) != nil && net.ParseIP(to) != nil
+	}
+	return false
+}
+
+// interfaceFromNetMacro returns the interface identifier a network macro
+// refers to, or value itself if it is not a `<if>ip` or `__<if>_*` macro.
+func interfaceFromNetMacro(value string) string {
+	if m := interfaceMacroRegex.FindStringSubmatch(value); m != nil {
+		return m[1]
+	}
+	if m := interfaceSuffixRegex.FindStringSubmatch(value); m != nil {
+		return m[1]
+	}
+	return value
+}
+
+// suggestion returns a " Did you mean ...?" hint for the closest candidate,
+// or "" if no candidate is close enough.
+func suggestion(value string, candidates []string) string {
+	best, bestDistance := "", -1
+	for _, c := range candidates {
+		d := levenshtein(strings.ToLower(value), strings.ToLower(c))
+		if bestDistance == -1 || d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+
+	// Only suggest candidates that differ by a typo, not a different name
+	if bestDistance == -1 || bestDistance > max(2, len(value)/3) {
+		return ""
+	}
+	return fmt.Sprintf(" Did you mean %q?", best)
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,40 @@
+package firewall
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNetLiteral(t *testing.T) {
+	for _, value := range []string{"", "any", "(self)", "10.0.0.1", "10.0.0.0/24", "fd00::1", "fd00::/64", "10.0.0.1-10.0.0.20"} {
+		require.True(t, isNetLiteral(value), value)
+	}
+	for _, value := range []string{"lan", "lanip", "__lan_network", "my_alias", "10.0.0.1-my_alias", "10.0.0.0/33"} {
+		require.False(t, isNetLiteral(value), value)
+	}
+}
+
+func TestInterfaceFromNetMacro(t *testing.T) {
+	require.Equal(t, "lan", interfaceFromNetMacro("lan"))
+	require.Equal(t, "lan", interfaceFromNetMacro("lanip"))
+	require.Equal(t, "opt1", interfaceFromNetMacro("__opt1_network"))
+	require.Equal(t, "wan", interfaceFromNetMacro("__wan_address"))
+	require.Equal(t, "my_alias", interfaceFromNetMacro("my_alias"))
+}
+
+func TestSuggestion(t *testing.T) {
+	candidates := []string{"web_servers", "dns_servers", "wan_gw"}
+
+	require.Equal(t, ` Did you mean "web_servers"?`, suggestion("web_server", candidates))
+	require.Equal(t, ` Did you mean "wan_gw"?`, suggestion("WAN_GW", candidates))
+	require.Equal(t, "", suggestion("something_else", candidates))
+	require.Equal(t, "", suggestion("web_server", nil))
+}
+
+func TestLevenshtein(t *testing.T) {
+	require.Equal(t, 0, levenshtein("alias", "alias"))
+	require.Equal(t, 1, levenshtein("alias", "alia"))
+	require.Equal(t, 3, levenshtein("kitten", "sitting"))
+	require.Equal(t, 5, levenshtein("", "alias"))
+}
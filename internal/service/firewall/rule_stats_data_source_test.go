@@ -0,0 +1,50 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallRuleStatsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleStatsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opnsense_firewall_rule_stats.test", "rules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opnsense_firewall_rule_stats.test", "rules.0.id", "opnsense_firewall_filter.test", "id"),
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_rule_stats.test", "rules.0.evaluations"),
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_rule_stats.test", "rules.0.packets"),
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_rule_stats.test", "rules.0.bytes"),
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_rule_stats.test", "rules.0.states"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleStatsDataSourceConfig() string {
+	return `
+resource "opnsense_firewall_filter" "test" {
+  description = "Testing rule stats"
+
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action    = "pass"
+    direction = "in"
+    protocol  = "any"
+  }
+}
+
+data "opnsense_firewall_rule_stats" "test" {
+  ids = [opnsense_firewall_filter.test.id]
+}
+`
+}
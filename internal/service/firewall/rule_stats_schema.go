@@ -0,0 +1,126 @@
+package firewall
+
+import (
+	"context"
+	"sort"
+
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ruleStatsDataSourceModel struct {
+	Ids   types.Set  `tfsdk:"ids"`
+	Rules types.List `tfsdk:"rules"`
+}
+
+type ruleStatsModel struct {
+	Id          types.String `tfsdk:"id"`
+	PfRules     types.Int64  `tfsdk:"pf_rules"`
+	Evaluations types.Int64  `tfsdk:"evaluations"`
+	Packets     types.Int64  `tfsdk:"packets"`
+	Bytes       types.Int64  `tfsdk:"bytes"`
+	States      types.Int64  `tfsdk:"states"`
+}
+
+var ruleStatsAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"pf_rules":    types.Int64Type,
+	"evaluations": types.Int64Type,
+	"packets":     types.Int64Type,
+	"bytes":       types.Int64Type,
+	"states":      types.Int64Type,
+}
+
+func ruleStatsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Rule statistics report the counters pf keeps for each firewall filter rule since the rules were last loaded. Use it to find rules that never match traffic.",
+
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "Set of filter rule UUIDs (e.g. `opnsense_firewall_filter.example.id`) to return statistics for. Returns statistics for all rules if omitted.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Statistics for each matching filter rule, sorted by UUID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the filter rule.",
+							Computed:            true,
+						},
+						"pf_rules": schema.Int64Attribute{
+							MarkdownDescription: "Number of pf rules generated from this filter rule.",
+							Computed:            true,
+						},
+						"evaluations": schema.Int64Attribute{
+							MarkdownDescription: "Number of times the rule has been evaluated.",
+							Computed:            true,
+						},
+						"packets": schema.Int64Attribute{
+							MarkdownDescription: "Number of packets that matched the rule.",
+							Computed:            true,
+						},
+						"bytes": schema.Int64Attribute{
+							MarkdownDescription: "Number of bytes that matched the rule.",
+							Computed:            true,
+						},
+						"states": schema.Int64Attribute{
+							MarkdownDescription: "Number of states currently created by the rule.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertRuleStatsStructToSchema(d map[string]firewall.RuleStats, ids []string) types.List {
+	// Only keep requested rules, unless no filter was given
+	wanted := map[string]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var keys []string
+	for id := range d {
+		if len(wanted) > 0 && !wanted[id] {
+			continue
+		}
+		keys = append(keys, id)
+	}
+	sort.Strings(keys)
+
+	var rules []ruleStatsModel
+	for _, id := range keys {
+		stats := d[id]
+		rules = append(rules, ruleStatsModel{
+			Id:          types.StringValue(id),
+			PfRules:     types.Int64Value(stats.PfRules),
+			Evaluations: types.Int64Value(stats.Evaluations),
+			Packets:     types.Int64Value(stats.Packets),
+			Bytes:       types.Int64Value(stats.Bytes),
+			States:      types.Int64Value(stats.States),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: ruleStatsAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(rules) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: ruleStatsAttrTypes},
+			rules,
+		)
+	}
+
+	return v
+}
@@ -0,0 +1,87 @@
+package firewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallScheduleResourceConfig("Testing schedule", "08:00", "17:59"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "name", "tf_acc_schedule"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "description", "Testing schedule"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "time_ranges.#", "2"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "time_ranges.0.weekdays.#", "5"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "time_ranges.0.start_time", "08:00"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "time_ranges.0.end_time", "17:59"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "time_ranges.1.months.#", "1"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "time_ranges.1.days.#", "2"),
+					resource.TestCheckResourceAttrPair("opnsense_firewall_filter.test", "filter.schedule", "opnsense_firewall_schedule.test", "name"),
+					resource.TestCheckResourceAttrSet("opnsense_firewall_schedule.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallScheduleResourceConfig("Updated schedule", "09:00", "12:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "description", "Updated schedule"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "time_ranges.0.start_time", "09:00"),
+					resource.TestCheckResourceAttr("opnsense_firewall_schedule.test", "time_ranges.0.end_time", "12:00"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFirewallScheduleResourceConfig(description, start, end string) string {
+	return fmt.Sprintf(`
+resource "opnsense_firewall_schedule" "test" {
+  name        = "tf_acc_schedule"
+  description = %[1]q
+
+  time_ranges = [
+    {
+      weekdays   = ["mon", "tue", "wed", "thu", "fri"]
+      start_time = %[2]q
+      end_time   = %[3]q
+    },
+    {
+      months      = [12]
+      days        = [25, 26]
+      start_time  = "00:00"
+      end_time    = "23:59"
+      description = "Christmas"
+    },
+  ]
+}
+
+resource "opnsense_firewall_filter" "test" {
+  interface = {
+    interface = ["lan"]
+  }
+
+  filter = {
+    action   = "pass"
+    schedule = opnsense_firewall_schedule.test.name
+  }
+
+  description = "Testing schedule filter"
+}
+`, description, start, end)
+}
@@ -0,0 +1,325 @@
+package firewall
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var scheduleTimeValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+	"must be a time in 24-hour HH:MM format (e.g. 08:00, 23:59)",
+)
+
+// scheduleWeekdays maps Terraform weekday names to the OPNsense weekday
+// numbers (1 = Monday, 7 = Sunday).
+var scheduleWeekdays = map[string]string{
+	"mon": "1",
+	"tue": "2",
+	"wed": "3",
+	"thu": "4",
+	"fri": "5",
+	"sat": "6",
+	"sun": "7",
+}
+
+// scheduleResourceModel describes the resource data model.
+type scheduleResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	TimeRanges  types.List   `tfsdk:"time_ranges"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+type scheduleTimeRangeModel struct {
+	Months      types.Set    `tfsdk:"months"`
+	Days        types.Set    `tfsdk:"days"`
+	Weekdays    types.Set    `tfsdk:"weekdays"`
+	StartTime   types.String `tfsdk:"start_time"`
+	EndTime     types.String `tfsdk:"end_time"`
+	Description types.String `tfsdk:"description"`
+}
+
+var scheduleTimeRangeAttrTypes = map[string]attr.Type{
+	"months":      types.SetType{ElemType: types.Int64Type},
+	"days":        types.SetType{ElemType: types.Int64Type},
+	"weekdays":    types.SetType{ElemType: types.StringType},
+	"start_time":  types.StringType,
+	"end_time":    types.StringType,
+	"description": types.StringType,
+}
+
+func scheduleResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Schedules define the times at which a firewall rule is active. A rule with a schedule only matches traffic during one of the schedule's time ranges. Rules reference schedules by `name`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the schedule. May only contain letters, digits and underscores, and must be at most 32 characters long.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9_]{1,32}$`),
+						"must only contain letters, digits and underscores, and be at most 32 characters long",
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"time_ranges": schema.ListNestedAttribute{
+				MarkdownDescription: "Time ranges during which the schedule is active. Each time range either repeats on the given `weekdays`, or applies to the given `days` of the given `months`.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"months": schema.SetAttribute{
+							MarkdownDescription: "Months (`1`-`12`) in which the `days` of this time range apply. Requires `days`.",
+							Optional:            true,
+							ElementType:         types.Int64Type,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueInt64sAre(int64validator.Between(1, 12)),
+								setvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("days")),
+							},
+						},
+						"days": schema.SetAttribute{
+							MarkdownDescription: "Days of the month (`1`-`31`) on which this time range applies. Requires `months`, conflicts with `weekdays`.",
+							Optional:            true,
+							ElementType:         types.Int64Type,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueInt64sAre(int64validator.Between(1, 31)),
+								setvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("months")),
+							},
+						},
+						"weekdays": schema.SetAttribute{
+							MarkdownDescription: "Days of the week on which this time range repeats. Any of `mon`, `tue`, `wed`, `thu`, `fri`, `sat` or `sun`. Conflicts with `days`.",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf("mon", "tue", "wed", "thu", "fri", "sat", "sun")),
+								setvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("days")),
+							},
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Time of day at which this time range starts, in `HH:MM` format (e.g. `08:00`).",
+							Required:            true,
+							Validators: []validator.String{
+								scheduleTimeValidator,
+							},
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "Time of day at which this time range ends, in `HH:MM` format (e.g. `17:59`).",
+							Required:            true,
+							Validators: []validator.String{
+								scheduleTimeValidator,
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Optional description of this time range. Defaults to `\"\"`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the schedule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func scheduleDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Schedules define the times at which a firewall rule is active. A rule with a schedule only matches traffic during one of the schedule's time ranges. Rules reference schedules by `name`.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the schedule.",
+				Required:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the schedule.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"time_ranges": dschema.ListNestedAttribute{
+				MarkdownDescription: "Time ranges during which the schedule is active.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"months": dschema.SetAttribute{
+							MarkdownDescription: "Months in which the `days` of this time range apply.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"days": dschema.SetAttribute{
+							MarkdownDescription: "Days of the month on which this time range applies.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"weekdays": dschema.SetAttribute{
+							MarkdownDescription: "Days of the week on which this time range repeats.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"start_time": dschema.StringAttribute{
+							MarkdownDescription: "Time of day at which this time range starts.",
+							Computed:            true,
+						},
+						"end_time": dschema.StringAttribute{
+							MarkdownDescription: "Time of day at which this time range ends.",
+							Computed:            true,
+						},
+						"description": dschema.StringAttribute{
+							MarkdownDescription: "Optional description of this time range.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertScheduleSchemaToStruct(d *scheduleResourceModel) (*firewall.Schedule, error) {
+	var rangesList []scheduleTimeRangeModel
+	d.TimeRanges.ElementsAs(context.Background(), &rangesList, false)
+
+	var timeRanges []firewall.ScheduleTimeRange
+	for _, r := range rangesList {
+		var months, days []int64
+		r.Months.ElementsAs(context.Background(), &months, false)
+		r.Days.ElementsAs(context.Background(), &days, false)
+
+		var weekdays []string
+		for _, w := range tools.SetToStringSlice(r.Weekdays) {
+			weekdays = append(weekdays, scheduleWeekdays[w])
+		}
+
+		timeRanges = append(timeRanges, firewall.ScheduleTimeRange{
+			Months:      int64SliceToStringSlice(months),
+			Days:        int64SliceToStringSlice(days),
+			Weekdays:    weekdays,
+			StartTime:   r.StartTime.ValueString(),
+			EndTime:     r.EndTime.ValueString(),
+			Description: r.Description.ValueString(),
+		})
+	}
+
+	return &firewall.Schedule{
+		Name:        d.Name.ValueString(),
+		Description: d.Description.ValueString(),
+		TimeRanges:  timeRanges,
+	}, nil
+}
+
+func convertScheduleStructToSchema(d *firewall.Schedule) (*scheduleResourceModel, error) {
+	model := &scheduleResourceModel{
+		Name:        types.StringValue(d.Name),
+		Description: types.StringValue(d.Description),
+	}
+
+	var timeRanges []scheduleTimeRangeModel
+	for _, r := range d.TimeRanges {
+		var weekdays []string
+		for _, w := range r.Weekdays {
+			for name, number := range scheduleWeekdays {
+				if number == w {
+					weekdays = append(weekdays, name)
+				}
+			}
+		}
+
+		timeRanges = append(timeRanges, scheduleTimeRangeModel{
+			Months:      stringSliceToInt64SetOrNull(r.Months),
+			Days:        stringSliceToInt64SetOrNull(r.Days),
+			Weekdays:    stringSliceToSetOrNull(weekdays),
+			StartTime:   types.StringValue(r.StartTime),
+			EndTime:     types.StringValue(r.EndTime),
+			Description: types.StringValue(r.Description),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: scheduleTimeRangeAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(timeRanges) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: scheduleTimeRangeAttrTypes},
+			timeRanges,
+		)
+	}
+	model.TimeRanges = v
+
+	return model, nil
+}
+
+func int64SliceToStringSlice(s []int64) []string {
+	var list []string
+	for _, i := range s {
+		list = append(list, tools.Int64ToString(i))
+	}
+	return list
+}
+
+// stringSliceToInt64SetOrNull converts a list of numeric strings to a set of
+// Int64, returning a null set if the list is empty so that unset optional
+// attributes don't produce a diff.
+func stringSliceToInt64SetOrNull(s []string) types.Set {
+	if len(s) == 0 {
+		return types.SetNull(types.Int64Type)
+	}
+
+	var list []attr.Value
+	for _, i := range s {
+		list = append(list, types.Int64Value(tools.StringToInt64(i)))
+	}
+	v, _ := types.SetValue(types.Int64Type, list)
+	return v
+}
+
+// stringSliceToSetOrNull converts a list of strings to a set of String,
+// returning a null set if the list is empty.
+func stringSliceToSetOrNull(s []string) types.Set {
+	if len(s) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return tools.StringSliceToSet(s)
+}
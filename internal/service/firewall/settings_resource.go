@@ -0,0 +1,104 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &settingsResource{}
+var _ resource.ResourceWithConfigure = &settingsResource{}
+var _ resource.ResourceWithImportState = &settingsResource{}
+
+func newSettingsResource() resource.Resource {
+	return &settingsResource{singletonResource{typeName: "firewall_settings", description: "firewall"}}
+}
+
+type settingsResource struct {
+	singletonResource
+}
+
+func (r *settingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = settingsResourceSchema()
+}
+
+func (r *settingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *settingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Firewall().AdvancedSettingsGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall settings, got error: %s", err))
+		return
+	}
+
+	resourceModel, err := convertSettingsStructToSchema(&result.Advanced)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse firewall settings, got error: %s", err))
+		return
+	}
+
+	resourceModel.Id = data.Id
+
+	tflog.Trace(ctx, "read firewall settings resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
+
+func (r *settingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *settingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceStruct, err := convertSettingsSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse firewall settings, got error: %s", err))
+		return
+	}
+
+	_, err = r.client.Firewall().AdvancedSettingsSet(ctx, resourceStruct)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update firewall settings, got error: %s", err))
+		return
+	}
+
+	_, err = r.client.Firewall().AdvancedSettingsReconfigure(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to reconfigure firewall settings, got error: %s", err))
+		return
+	}
+
+	result, err := r.client.Firewall().AdvancedSettingsGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read updated firewall settings, got error: %s", err))
+		return
+	}
+
+	resourceModel, err := convertSettingsStructToSchema(&result.Advanced)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse updated firewall settings, got error: %s", err))
+		return
+	}
+
+	resourceModel.Id = data.Id
+
+	tflog.Trace(ctx, "updated firewall settings resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
@@ -0,0 +1,81 @@
+package firewall_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFirewallSettingsResource tests the singleton firewall settings resource.
+// Because this resource blocks creation, the test begins with an import step.
+func TestAccFirewallSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccFirewallSettingsResourceConfig("normal", "floating", -1),
+				ResourceName:       "opnsense_firewall_settings.test",
+				ImportState:        true,
+				ImportStateId:      "firewall_settings",
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccFirewallSettingsResourceConfig("normal", "floating", -1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "id", "firewall_settings"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "optimization", "normal"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "state_policy", "floating"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "state_table_size", "-1"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "adaptive_timeouts.start", "-1"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "bogons_update_interval", "monthly"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "disable_reply_to", "false"),
+				),
+			},
+			{
+				Config: testAccFirewallSettingsResourceConfig("conservative", "if-bound", 200000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "optimization", "conservative"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "state_policy", "if-bound"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "state_table_size", "200000"),
+				),
+			},
+			// Restore original state
+			{
+				Config: testAccFirewallSettingsResourceConfig("normal", "floating", -1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "optimization", "normal"),
+					resource.TestCheckResourceAttr("opnsense_firewall_settings.test", "state_table_size", "-1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccFirewallSettingsResource_CreateBlocked verifies that attempting to
+// create this singleton resource without importing it first returns a clear error.
+func TestAccFirewallSettingsResource_CreateBlocked(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      `resource "opnsense_firewall_settings" "test" {}`,
+				ExpectError: regexp.MustCompile("Cannot Create Singleton Resource"),
+			},
+		},
+	})
+}
+
+func testAccFirewallSettingsResourceConfig(optimization, statePolicy string, stateTableSize int) string {
+	return fmt.Sprintf(`
+resource "opnsense_firewall_settings" "test" {
+  optimization     = %[1]q
+  state_policy     = %[2]q
+  state_table_size = %[3]d
+}
+`, optimization, statePolicy, stateTableSize)
+}
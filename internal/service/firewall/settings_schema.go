@@ -0,0 +1,243 @@
+package firewall
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// settingsResourceModel describes the resource data model.
+// This is a SINGLETON resource — it manages existing upstream configuration
+// that cannot be created or destroyed via Terraform.
+type settingsResourceModel struct {
+	Id                   types.String            `tfsdk:"id"`
+	Optimization         types.String            `tfsdk:"optimization"`
+	StatePolicy          types.String            `tfsdk:"state_policy"`
+	StateTableSize       types.Int64             `tfsdk:"state_table_size"`
+	TableEntries         types.Int64             `tfsdk:"table_entries"`
+	AdaptiveTimeouts     *filterAdaptiveTimeouts `tfsdk:"adaptive_timeouts"`
+	BogonsUpdateInterval types.String            `tfsdk:"bogons_update_interval"`
+	BypassStaticRoutes   types.Bool              `tfsdk:"bypass_static_routes"`
+	DisableReplyTo       types.Bool              `tfsdk:"disable_reply_to"`
+	DisableAntiLockout   types.Bool              `tfsdk:"disable_anti_lockout"`
+}
+
+func settingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manages the global firewall and NAT options of the advanced firewall settings, which provide the defaults that `opnsense_firewall_filter` rules fall back to. This is a singleton resource that manages existing upstream configuration.\n\n" +
+			"**Important:** This resource must be imported before it can be managed:\n" +
+			"```bash\n" +
+			"terraform import opnsense_firewall_settings.settings firewall_settings\n" +
+			"```\n\n" +
+			"After importing, you can manage the configuration with `terraform apply`. " +
+			"Running `terraform destroy` will remove the resource from state but will NOT modify the upstream configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always set to `firewall_settings`. Use this value when importing: `terraform import opnsense_firewall_settings.settings firewall_settings`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"optimization": schema.StringAttribute{
+				MarkdownDescription: "The optimization algorithm for state table timeouts. One of `normal` (for most networks), `high-latency` (for e.g. satellite links, expires idle connections later), `aggressive` (expires idle connections quicker, may drop legitimate idle connections) or `conservative` (avoids dropping idle connections at the expense of memory and CPU). Defaults to `normal`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("normal"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("normal", "high-latency", "aggressive", "conservative"),
+				},
+			},
+			"state_policy": schema.StringAttribute{
+				MarkdownDescription: "The default state policy, used by rules whose `stateful_firewall.policy` is empty. One of `floating` (states are valid on all interfaces) or `if-bound` (states are bound to the interface they were created on, which is more secure). Defaults to `floating`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("floating"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("floating", "if-bound"),
+				},
+			},
+			"state_table_size": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of connections to hold in the state table. Set to `-1` to use the system default, which is 10% of the total RAM. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(int64validator.OneOf(-1), int64validator.AtLeast(1)),
+				},
+			},
+			"table_entries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of table entries for systems such as aliases, sshlockout, bogons, etc, combined. Set to `-1` to use the system default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(int64validator.OneOf(-1), int64validator.AtLeast(1)),
+				},
+			},
+			"adaptive_timeouts": schema.SingleNestedAttribute{
+				MarkdownDescription: "The default adaptive timeouts, used by rules that do not set `stateful_firewall.adaptive_timeouts`. Timeouts are scaled linearly with factor `(adaptive_timeouts.end - number of states) / (adaptive_timeouts.end - adaptive_timeouts.start)`.",
+				Optional:            true,
+				Computed:            true,
+				Default: objectdefault.StaticValue(
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"start": types.Int64Type,
+							"end":   types.Int64Type,
+						},
+						map[string]attr.Value{
+							"start": types.Int64Value(-1),
+							"end":   types.Int64Value(-1),
+						},
+					),
+				),
+				Attributes: map[string]schema.Attribute{
+					"start": schema.Int64Attribute{
+						MarkdownDescription: "When the number of state entries exceeds this value, adaptive scaling begins. Set to `-1` to use the system default, which is 60% of `state_table_size`. Defaults to `-1`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(-1),
+					},
+					"end": schema.Int64Attribute{
+						MarkdownDescription: "When reaching this number of state entries, all timeout values become zero, effectively purging all state entries immediately. Set to `-1` to use the system default, which is 120% of `state_table_size`. Defaults to `-1`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(-1),
+					},
+				},
+			},
+			"bogons_update_interval": schema.StringAttribute{
+				MarkdownDescription: "How often the bogon networks tables are updated. One of `monthly`, `weekly` or `daily`. Defaults to `monthly`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("monthly"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("monthly", "weekly", "daily"),
+				},
+			},
+			"bypass_static_routes": schema.BoolAttribute{
+				MarkdownDescription: "Whether to bypass the firewall rules for traffic on the same interface, for static routes whose gateway is on the interface the traffic entered on. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disable_reply_to": schema.BoolAttribute{
+				MarkdownDescription: "Whether to disable reply-to on WAN rules globally. Rules can still set `source_routing.reply_to` explicitly. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disable_anti_lockout": schema.BoolAttribute{
+				MarkdownDescription: "Whether to disable the automatically added rule that keeps the web interface reachable from the LAN. Make sure a filter rule allows access to the web interface before setting this. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func settingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Reads the global firewall and NAT options of the advanced firewall settings from the upstream system.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always set to `firewall_settings`.",
+			},
+			"optimization": dschema.StringAttribute{
+				MarkdownDescription: "The optimization algorithm for state table timeouts. One of `normal`, `high-latency`, `aggressive` or `conservative`.",
+				Computed:            true,
+			},
+			"state_policy": dschema.StringAttribute{
+				MarkdownDescription: "The default state policy. One of `floating` or `if-bound`.",
+				Computed:            true,
+			},
+			"state_table_size": dschema.Int64Attribute{
+				MarkdownDescription: "The maximum number of connections to hold in the state table, or `-1` for the system default.",
+				Computed:            true,
+			},
+			"table_entries": dschema.Int64Attribute{
+				MarkdownDescription: "The maximum number of table entries, or `-1` for the system default.",
+				Computed:            true,
+			},
+			"adaptive_timeouts": dschema.SingleNestedAttribute{
+				MarkdownDescription: "The default adaptive timeouts.",
+				Computed:            true,
+				Attributes: map[string]dschema.Attribute{
+					"start": dschema.Int64Attribute{
+						MarkdownDescription: "Number of state entries at which adaptive scaling begins, or `-1` for the system default.",
+						Computed:            true,
+					},
+					"end": dschema.Int64Attribute{
+						MarkdownDescription: "Number of state entries at which all timeout values become zero, or `-1` for the system default.",
+						Computed:            true,
+					},
+				},
+			},
+			"bogons_update_interval": dschema.StringAttribute{
+				MarkdownDescription: "How often the bogon networks tables are updated. One of `monthly`, `weekly` or `daily`.",
+				Computed:            true,
+			},
+			"bypass_static_routes": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the firewall rules are bypassed for static route traffic on the same interface.",
+				Computed:            true,
+			},
+			"disable_reply_to": dschema.BoolAttribute{
+				MarkdownDescription: "Whether reply-to on WAN rules is disabled globally.",
+				Computed:            true,
+			},
+			"disable_anti_lockout": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the anti-lockout rule is disabled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertSettingsSchemaToStruct(d *settingsResourceModel) (*firewall.AdvancedSettings, error) {
+	return &firewall.AdvancedSettings{
+		Optimization:        api.SelectedMap(d.Optimization.ValueString()),
+		StatePolicy:         api.SelectedMap(d.StatePolicy.ValueString()),
+		MaximumStates:       tools.Int64ToStringNegative(d.StateTableSize.ValueInt64()),
+		MaximumTableEntries: tools.Int64ToStringNegative(d.TableEntries.ValueInt64()),
+		AdaptiveStart:       tools.Int64ToStringNegative(d.AdaptiveTimeouts.Start.ValueInt64()),
+		AdaptiveEnd:         tools.Int64ToStringNegative(d.AdaptiveTimeouts.End.ValueInt64()),
+		BogonsInterval:      api.SelectedMap(d.BogonsUpdateInterval.ValueString()),
+		BypassStaticRoutes:  tools.BoolToString(d.BypassStaticRoutes.ValueBool()),
+		DisableReplyTo:      tools.BoolToString(d.DisableReplyTo.ValueBool()),
+		DisableAntiLockout:  tools.BoolToString(d.DisableAntiLockout.ValueBool()),
+	}, nil
+}
+
+func convertSettingsStructToSchema(d *firewall.AdvancedSettings) (*settingsResourceModel, error) {
+	return &settingsResourceModel{
+		Optimization:   types.StringValue(d.Optimization.String()),
+		StatePolicy:    types.StringValue(d.StatePolicy.String()),
+		StateTableSize: types.Int64Value(tools.StringToInt64(d.MaximumStates)),
+		TableEntries:   types.Int64Value(tools.StringToInt64(d.MaximumTableEntries)),
+		AdaptiveTimeouts: &filterAdaptiveTimeouts{
+			Start: types.Int64Value(tools.StringToInt64(d.AdaptiveStart)),
+			End:   types.Int64Value(tools.StringToInt64(d.AdaptiveEnd)),
+		},
+		BogonsUpdateInterval: types.StringValue(d.BogonsInterval.String()),
+		BypassStaticRoutes:   types.BoolValue(tools.StringToBool(d.BypassStaticRoutes)),
+		DisableReplyTo:       types.BoolValue(tools.StringToBool(d.DisableReplyTo)),
+		DisableAntiLockout:   types.BoolValue(tools.StringToBool(d.DisableAntiLockout)),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// singletonResource implements the parts of an import-only singleton
+// resource that do not depend on its settings: it cannot be created, is
+// only removed from state on destroy, and is imported with its type name as
+// ID. Settings resources embed it and implement Schema, Read and Update.
+type singletonResource struct {
+	client opnsense.Client
+
+	// typeName is the resource type name without the provider prefix (e.g.
+	// "firewall_settings"), which is also the import ID.
+	typeName string
+
+	// description names the upstream configuration in messages (e.g. "NAT").
+	description string
+}
+
+func (r *singletonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *singletonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *singletonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.AddError(
+		"Cannot Create Singleton Resource",
+		fmt.Sprintf("This resource manages existing upstream %s configuration that cannot be created or destroyed.\n\n", r.description)+
+			"To manage this resource, you must import it first:\n"+
+			fmt.Sprintf("  terraform import opnsense_%[1]s.<name> %[1]s\n\n", r.typeName)+
+			"After importing, you can manage the configuration with terraform apply.",
+	)
+}
+
+func (r *singletonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Warn(ctx,
+		"Singleton resource removed from Terraform state. "+
+			fmt.Sprintf("The upstream %s configuration remains unchanged and will not be deleted.", r.description))
+
+	resp.Diagnostics.AddWarning(
+		"Singleton Resource Removed From State Only",
+		"This resource has been removed from Terraform state, but the upstream "+
+			fmt.Sprintf("%s configuration has NOT been deleted or modified. The settings ", r.description)+
+			"remain active in the upstream system.\n\n"+
+			"To manage this resource again in the future, re-import it:\n"+
+			fmt.Sprintf("  terraform import opnsense_%[1]s.<name> %[1]s", r.typeName),
+	)
+}
+
+func (r *singletonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != r.typeName {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("This is a singleton resource and must be imported using the ID '%s'.\n\n", r.typeName)+
+				"Usage:\n"+
+				fmt.Sprintf("  terraform import opnsense_%[1]s.<name> %[1]s\n\n", r.typeName)+
+				fmt.Sprintf("You provided: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+
+	tflog.Info(ctx, "imported singleton resource", map[string]any{"type": r.typeName, "id": req.ID})
+}
@@ -0,0 +1,108 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &statesDataSource{}
+var _ datasource.DataSourceWithConfigure = &statesDataSource{}
+
+func newStatesDataSource() datasource.DataSource {
+	return &statesDataSource{}
+}
+
+// statesDataSource defines the data source implementation.
+type statesDataSource struct {
+	client opnsense.Client
+}
+
+func (d *statesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_states"
+}
+
+func (d *statesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = statesDataSourceSchema()
+}
+
+func (d *statesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+// statesPageSize is the number of states requested from the state table at
+// once.
+const statesPageSize = 1000
+
+func (d *statesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *statesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(1000)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	// Get states from OPNsense API, page by page, until enough states match.
+	// The rule and address filters are applied server-side, the address filter
+	// only as a search phrase, so both filters are applied again on the result.
+	var states []diagnostics.State
+	for page := int64(1); int64(len(states)) < limit; page++ {
+		rows, err := d.client.Diagnostics().QueryStates(ctx, &diagnostics.StateQuery{
+			RuleId:       data.RuleId.ValueString(),
+			SearchPhrase: data.Address.ValueString(),
+			RowCount:     statesPageSize,
+			Current:      page,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read firewall states, got error: %s", err))
+			return
+		}
+
+		for i := range rows {
+			if stateMatchesFilter(&rows[i], data.Interface.ValueString(), data.Address.ValueString()) {
+				states = append(states, rows[i])
+			}
+		}
+
+		// The last page is reached
+		if int64(len(rows)) < statesPageSize {
+			break
+		}
+	}
+	if int64(len(states)) > limit {
+		states = states[:limit]
+	}
+
+	// Convert OPNsense struct to TF schema
+	data.States = convertStatesStructToSchema(states)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
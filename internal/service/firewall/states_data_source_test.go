@@ -0,0 +1,47 @@
+package firewall_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallStatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The acceptance test connection to the API guarantees at least one state
+				Config: testAccFirewallStatesDataSourceConfig(100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_states.test", "states.#"),
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_states.test", "states.0.protocol"),
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_states.test", "states.0.source_address"),
+					resource.TestCheckResourceAttrSet("data.opnsense_firewall_states.test", "states.0.destination_address"),
+				),
+			},
+			{
+				Config: testAccFirewallStatesDataSourceConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opnsense_firewall_states.test", "states.#", "1"),
+				),
+			},
+			{
+				Config:      testAccFirewallStatesDataSourceConfig(0),
+				ExpectError: regexp.MustCompile("must be at least 1"),
+			},
+		},
+	})
+}
+
+func testAccFirewallStatesDataSourceConfig(limit int) string {
+	return fmt.Sprintf(`
+data "opnsense_firewall_states" "test" {
+  limit = %[1]d
+}
+`, limit)
+}
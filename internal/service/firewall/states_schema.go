@@ -0,0 +1,226 @@
+package firewall
+
+import (
+	"context"
+
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type statesDataSourceModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Address   types.String `tfsdk:"address"`
+	RuleId    types.String `tfsdk:"rule_id"`
+	Limit     types.Int64  `tfsdk:"limit"`
+	States    types.List   `tfsdk:"states"`
+}
+
+type stateModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Interface          types.String `tfsdk:"interface"`
+	Protocol           types.String `tfsdk:"protocol"`
+	IpProtocol         types.String `tfsdk:"ip_protocol"`
+	Direction          types.String `tfsdk:"direction"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	SourcePort         types.String `tfsdk:"source_port"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	DestinationPort    types.String `tfsdk:"destination_port"`
+	NatAddress         types.String `tfsdk:"nat_address"`
+	NatPort            types.String `tfsdk:"nat_port"`
+	State              types.String `tfsdk:"state"`
+	RuleId             types.String `tfsdk:"rule_id"`
+	Description        types.String `tfsdk:"description"`
+	Age                types.String `tfsdk:"age"`
+	Expires            types.String `tfsdk:"expires"`
+	Packets            types.Int64  `tfsdk:"packets"`
+	Bytes              types.Int64  `tfsdk:"bytes"`
+}
+
+var stateAttrTypes = map[string]attr.Type{
+	"id":                  types.StringType,
+	"interface":           types.StringType,
+	"protocol":            types.StringType,
+	"ip_protocol":         types.StringType,
+	"direction":           types.StringType,
+	"source_address":      types.StringType,
+	"source_port":         types.StringType,
+	"destination_address": types.StringType,
+	"destination_port":    types.StringType,
+	"nat_address":         types.StringType,
+	"nat_port":            types.StringType,
+	"state":               types.StringType,
+	"rule_id":             types.StringType,
+	"description":         types.StringType,
+	"age":                 types.StringType,
+	"expires":             types.StringType,
+	"packets":             types.Int64Type,
+	"bytes":               types.Int64Type,
+}
+
+func statesDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "States returns entries from the pf state table, optionally filtered by interface, address or the filter rule that created them.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Only return states on this interface device (e.g. `vtnet0`).",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Only return states where the source, destination or NAT address equals this IP address.",
+				Optional:            true,
+			},
+			"rule_id": schema.StringAttribute{
+				MarkdownDescription: "Only return states created by the filter rule with this UUID (e.g. `opnsense_firewall_filter.example.id`).",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of matching states to return, after filtering. Defaults to `1000` if omitted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"states": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of matching states.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the state.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Interface device the state was created on.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol of the state (e.g. `tcp`).",
+							Computed:            true,
+						},
+						"ip_protocol": schema.StringAttribute{
+							MarkdownDescription: "IP version of the state (e.g. `inet`).",
+							Computed:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Direction of the state, either `in` or `out`.",
+							Computed:            true,
+						},
+						"source_address": schema.StringAttribute{
+							MarkdownDescription: "Source address of the state.",
+							Computed:            true,
+						},
+						"source_port": schema.StringAttribute{
+							MarkdownDescription: "Source port of the state.",
+							Computed:            true,
+						},
+						"destination_address": schema.StringAttribute{
+							MarkdownDescription: "Destination address of the state.",
+							Computed:            true,
+						},
+						"destination_port": schema.StringAttribute{
+							MarkdownDescription: "Destination port of the state.",
+							Computed:            true,
+						},
+						"nat_address": schema.StringAttribute{
+							MarkdownDescription: "Translated address of the state, if any.",
+							Computed:            true,
+						},
+						"nat_port": schema.StringAttribute{
+							MarkdownDescription: "Translated port of the state, if any.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Connection state (e.g. `ESTABLISHED:ESTABLISHED`).",
+							Computed:            true,
+						},
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the filter rule that created the state, if known.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the rule that created the state.",
+							Computed:            true,
+						},
+						"age": schema.StringAttribute{
+							MarkdownDescription: "Time since the state was created.",
+							Computed:            true,
+						},
+						"expires": schema.StringAttribute{
+							MarkdownDescription: "Time until the state expires.",
+							Computed:            true,
+						},
+						"packets": schema.Int64Attribute{
+							MarkdownDescription: "Number of packets that passed through the state.",
+							Computed:            true,
+						},
+						"bytes": schema.Int64Attribute{
+							MarkdownDescription: "Number of bytes that passed through the state.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// stateMatchesFilter returns true if the state matches the interface and
+// address filters of the data source. Empty filters match everything.
+func stateMatchesFilter(s *diagnostics.State, iface string, address string) bool {
+	if iface != "" && s.Interface != iface {
+		return false
+	}
+	if address != "" && s.SourceAddress != address && s.DestinationAddress != address && s.NatAddress != address {
+		return false
+	}
+	return true
+}
+
+func convertStatesStructToSchema(d []diagnostics.State) types.List {
+	var states []stateModel
+	for i := range d {
+		s := &d[i]
+
+		states = append(states, stateModel{
+			Id:                 types.StringValue(s.Id),
+			Interface:          types.StringValue(s.Interface),
+			Protocol:           types.StringValue(s.Protocol),
+			IpProtocol:         types.StringValue(s.IpProtocol),
+			Direction:          types.StringValue(s.Direction),
+			SourceAddress:      types.StringValue(s.SourceAddress),
+			SourcePort:         types.StringValue(s.SourcePort),
+			DestinationAddress: types.StringValue(s.DestinationAddress),
+			DestinationPort:    types.StringValue(s.DestinationPort),
+			NatAddress:         types.StringValue(s.NatAddress),
+			NatPort:            types.StringValue(s.NatPort),
+			State:              types.StringValue(s.State),
+			RuleId:             types.StringValue(s.Label),
+			Description:        types.StringValue(s.Description),
+			Age:                types.StringValue(s.Age),
+			Expires:            types.StringValue(s.Expires),
+			Packets:            types.Int64Value(s.Packets),
+			Bytes:              types.Int64Value(s.Bytes),
+		})
+	}
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: stateAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(states) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: stateAttrTypes},
+			states,
+		)
+	}
+
+	return v
+}
@@ -0,0 +1,255 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &assignmentResource{}
+var _ resource.ResourceWithConfigure = &assignmentResource{}
+var _ resource.ResourceWithImportState = &assignmentResource{}
+var _ resource.ResourceWithConfigValidators = &assignmentResource{}
+
+func newAssignmentResource() resource.Resource {
+	return &assignmentResource{}
+}
+
+// assignmentResource defines the resource implementation.
+type assignmentResource struct {
+	client opnsense.Client
+}
+
+func (r *assignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interfaces_assignment"
+}
+
+func (r *assignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = assignmentResourceSchema()
+}
+
+func (r *assignmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// Static addresses must be set when the type is "static"
+		validators.RequiredWhenStringEqualsOneOf(
+			path.MatchRoot("ipv4_address"),
+			path.MatchRoot("ipv4_type"),
+			[]string{"static"},
+		),
+		validators.RequiredWhenStringEqualsOneOf(
+			path.MatchRoot("ipv6_address"),
+			path.MatchRoot("ipv6_type"),
+			[]string{"static"},
+		),
+		validators.RequiredWhenStringEqualsOneOf(
+			path.MatchRoot("track6_interface"),
+			path.MatchRoot("ipv6_type"),
+			[]string{"track6"},
+		),
+
+		// Type specific settings only apply to their type
+		validators.RequiresStringEqualsOneOf(
+			path.MatchRoot("ipv4_address"),
+			path.MatchRoot("ipv4_type"),
+			[]string{"static"},
+		),
+		validators.RequiresStringEqualsOneOf(
+			path.MatchRoot("ipv4_gateway"),
+			path.MatchRoot("ipv4_type"),
+			[]string{"static"},
+		),
+		validators.RequiresStringEqualsOneOf(
+			path.MatchRoot("ipv6_address"),
+			path.MatchRoot("ipv6_type"),
+			[]string{"static"},
+		),
+		validators.RequiresStringEqualsOneOf(
+			path.MatchRoot("ipv6_gateway"),
+			path.MatchRoot("ipv6_type"),
+			[]string{"static"},
+		),
+		validators.RequiresStringEqualsOneOf(
+			path.MatchRoot("track6_interface"),
+			path.MatchRoot("ipv6_type"),
+			[]string{"track6"},
+		),
+		validators.RequiresStringEqualsOneOf(
+			path.MatchRoot("track6_prefix_id"),
+			path.MatchRoot("ipv6_type"),
+			[]string{"track6"},
+		),
+	}
+}
+
+func (r *assignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *assignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *assignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	assignment, err := convertAssignmentSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse interface assignment, got error: %s", err))
+		return
+	}
+
+	// Add interface assignment to OPNsense interfaces
+	id, err := r.client.Interfaces().AddAssignment(ctx, assignment)
+	if err != nil {
+		if id != "" {
+			data.Id = types.StringValue(id)
+			data.Identifier = types.StringValue(id)
+
+			if readModel := readBackCreated(ctx, id, r.client.Interfaces().GetAssignment, convertAssignmentStructToSchema); readModel != nil {
+				readModel.Id = data.Id
+				readModel.Identifier = data.Id
+				data = readModel
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create interface assignment, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense, which is the identifier of the
+	// new interface (e.g. opt1)
+	data.Id = types.StringValue(id)
+	data.Identifier = types.StringValue(id)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *assignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *assignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get interface assignment from OPNsense core API
+	assignment, err := r.client.Interfaces().GetAssignment(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("interface assignment not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read interface assignment, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	assignmentModel, err := convertAssignmentStructToSchema(assignment)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read interface assignment, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	assignmentModel.Id = data.Id
+	assignmentModel.Identifier = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &assignmentModel)...)
+}
+
+func (r *assignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *assignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	assignment, err := convertAssignmentSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse interface assignment, got error: %s", err))
+		return
+	}
+
+	// Update interface assignment in OPNsense core
+	err = r.client.Interfaces().UpdateAssignment(ctx, data.Id.ValueString(), assignment)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update interface assignment, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *assignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *assignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Interfaces().DeleteAssignment(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete interface assignment, got error: %s", err))
+		return
+	}
+}
+
+func (r *assignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,89 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesAssignmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssignmentResourceConfig("Assignment test", "192.168.110.1/24", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_assignment.test", "id"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_assignment.test", "identifier", regexp.MustCompile(`^opt[0-9]+$`)),
+					resource.TestCheckResourceAttrPair("opnsense_interfaces_assignment.test", "device", "opnsense_interfaces_vlan.test", "device"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "description", "Assignment test"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "mtu", "-1"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "ipv4_type", "static"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "ipv4_address", "192.168.110.1/24"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "ipv6_type", "none"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "block_private", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccAssignmentResourceConfig("Updated assignment", "192.168.111.1/24", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "description", "Updated assignment"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "ipv4_address", "192.168.111.1/24"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "block_private", "true"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_assignment.test", "block_bogons", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInterfacesAssignmentResourceRequiresAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "opnsense_interfaces_assignment" "test" {
+  device    = "vlan02"
+  ipv4_type = "static"
+}
+`,
+				ExpectError: regexp.MustCompile("Missing Required Attribute"),
+			},
+		},
+	})
+}
+
+func testAccAssignmentResourceConfig(description, address string, block bool) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_vlan" "test" {
+  tag    = 110
+  parent = "vtnet0"
+  device = "vlan0110"
+}
+
+resource "opnsense_interfaces_assignment" "test" {
+  device        = opnsense_interfaces_vlan.test.device
+  description   = %[1]q
+  ipv4_type     = "static"
+  ipv4_address  = %[2]q
+  block_private = %[3]t
+  block_bogons  = %[3]t
+}
+`, description, address, block)
+}
@@ -0,0 +1,298 @@
+package interfaces
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// assignmentResourceModel describes the resource data model.
+type assignmentResourceModel struct {
+	Device          types.String `tfsdk:"device"`
+	Description     types.String `tfsdk:"description"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	MTU             types.Int64  `tfsdk:"mtu"`
+	MSS             types.Int64  `tfsdk:"mss"`
+	IPv4Type        types.String `tfsdk:"ipv4_type"`
+	IPv4Address     types.String `tfsdk:"ipv4_address"`
+	IPv4Gateway     types.String `tfsdk:"ipv4_gateway"`
+	IPv6Type        types.String `tfsdk:"ipv6_type"`
+	IPv6Address     types.String `tfsdk:"ipv6_address"`
+	IPv6Gateway     types.String `tfsdk:"ipv6_gateway"`
+	Track6Interface types.String `tfsdk:"track6_interface"`
+	Track6PrefixId  types.Int64  `tfsdk:"track6_prefix_id"`
+	BlockPrivate    types.Bool   `tfsdk:"block_private"`
+	BlockBogons     types.Bool   `tfsdk:"block_bogons"`
+	Identifier      types.String `tfsdk:"identifier"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func assignmentResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Assigns a device (e.g. a VLAN created by `opnsense_interfaces_vlan`) to a logical interface (e.g. `opt1`), and configures its addresses. Use `identifier` to reference the interface in e.g. firewall rules.",
+
+		Attributes: map[string]schema.Attribute{
+			"device": schema.StringAttribute{
+				MarkdownDescription: "The device to assign, e.g. `vlan01` or `vtnet1`. A device can only be assigned to one interface.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the interface, shown instead of the identifier in the GUI.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this interface. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "MTU of the interface. Set to `-1` to use the MTU of the device. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(576, 65535),
+					),
+				},
+			},
+			"mss": schema.Int64Attribute{
+				MarkdownDescription: "MSS clamping for TCP connections over this interface. Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(576, 65535),
+					),
+				},
+			},
+			"ipv4_type": schema.StringAttribute{
+				MarkdownDescription: "IPv4 configuration type. One of `none`, `static` or `dhcp`. `static` requires `ipv4_address`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "static", "dhcp"),
+				},
+			},
+			"ipv4_address": schema.StringAttribute{
+				MarkdownDescription: "IPv4 address and subnet of the interface, e.g. `192.168.10.1/24`. Only used when `ipv4_type` is `static`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.CIDR(),
+				},
+			},
+			"ipv4_gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the IPv4 upstream gateway of the interface, e.g. `WAN_GW`. Leave empty for LAN type interfaces. Only used when `ipv4_type` is `static`.",
+				Optional:            true,
+			},
+			"ipv6_type": schema.StringAttribute{
+				MarkdownDescription: "IPv6 configuration type. One of `none`, `static`, `dhcp6`, `slaac` or `track6`. `static` requires `ipv6_address`, `track6` requires `track6_interface`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "static", "dhcp6", "slaac", "track6"),
+				},
+			},
+			"ipv6_address": schema.StringAttribute{
+				MarkdownDescription: "IPv6 address and prefix length of the interface, e.g. `2001:db8::1/64`. Only used when `ipv6_type` is `static`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.CIDR(),
+				},
+			},
+			"ipv6_gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the IPv6 upstream gateway of the interface, e.g. `WAN_GW6`. Leave empty for LAN type interfaces. Only used when `ipv6_type` is `static`.",
+				Optional:            true,
+			},
+			"track6_interface": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the interface to track the delegated IPv6 prefix of, e.g. `wan`. Required when `ipv6_type` is `track6`.",
+				Optional:            true,
+			},
+			"track6_prefix_id": schema.Int64Attribute{
+				MarkdownDescription: "The prefix ID used to select the subnet of the delegated prefix for this interface. Only used when `ipv6_type` is `track6`. Defaults to `0` if omitted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"block_private": schema.BoolAttribute{
+				MarkdownDescription: "Block traffic from private networks (RFC 1918) and loopback addresses on this interface. Usually only enabled on WAN type interfaces. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"block_bogons": schema.BoolAttribute{
+				MarkdownDescription: "Block traffic from reserved and not yet assigned networks (bogons) on this interface. Usually only enabled on WAN type interfaces. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the interface, e.g. `opt1`. This is the name used by firewall rules and other resources to reference the interface.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the interface, same as `identifier`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func assignmentDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Assigns a device to a logical interface (e.g. `opt1`), and configures its addresses.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "Identifier of the interface, e.g. `opt1`.",
+				Required:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "The device assigned to the interface.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the interface.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the interface is enabled.",
+				Computed:            true,
+			},
+			"mtu": dschema.Int64Attribute{
+				MarkdownDescription: "MTU of the interface, `-1` if the MTU of the device is used.",
+				Computed:            true,
+			},
+			"mss": dschema.Int64Attribute{
+				MarkdownDescription: "MSS clamping for TCP connections over this interface, `-1` if disabled.",
+				Computed:            true,
+			},
+			"ipv4_type": dschema.StringAttribute{
+				MarkdownDescription: "IPv4 configuration type. One of `none`, `static` or `dhcp`.",
+				Computed:            true,
+			},
+			"ipv4_address": dschema.StringAttribute{
+				MarkdownDescription: "Static IPv4 address and subnet of the interface.",
+				Computed:            true,
+			},
+			"ipv4_gateway": dschema.StringAttribute{
+				MarkdownDescription: "Name of the IPv4 upstream gateway of the interface.",
+				Computed:            true,
+			},
+			"ipv6_type": dschema.StringAttribute{
+				MarkdownDescription: "IPv6 configuration type. One of `none`, `static`, `dhcp6`, `slaac` or `track6`.",
+				Computed:            true,
+			},
+			"ipv6_address": dschema.StringAttribute{
+				MarkdownDescription: "Static IPv6 address and prefix length of the interface.",
+				Computed:            true,
+			},
+			"ipv6_gateway": dschema.StringAttribute{
+				MarkdownDescription: "Name of the IPv6 upstream gateway of the interface.",
+				Computed:            true,
+			},
+			"track6_interface": dschema.StringAttribute{
+				MarkdownDescription: "Identifier of the interface whose delegated IPv6 prefix is tracked.",
+				Computed:            true,
+			},
+			"track6_prefix_id": dschema.Int64Attribute{
+				MarkdownDescription: "The prefix ID used to select the subnet of the delegated prefix.",
+				Computed:            true,
+			},
+			"block_private": dschema.BoolAttribute{
+				MarkdownDescription: "Whether traffic from private networks is blocked on this interface.",
+				Computed:            true,
+			},
+			"block_bogons": dschema.BoolAttribute{
+				MarkdownDescription: "Whether traffic from bogon networks is blocked on this interface.",
+				Computed:            true,
+			},
+			"identifier": dschema.StringAttribute{
+				MarkdownDescription: "Identifier of the interface, e.g. `opt1`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertAssignmentSchemaToStruct(d *assignmentResourceModel) (*interfaces.Assignment, error) {
+	track6PrefixId := ""
+	if !d.Track6PrefixId.IsNull() {
+		track6PrefixId = tools.Int64ToString(d.Track6PrefixId.ValueInt64())
+	}
+
+	return &interfaces.Assignment{
+		Device:          d.Device.ValueString(),
+		Description:     d.Description.ValueString(),
+		Enabled:         tools.BoolToString(d.Enabled.ValueBool()),
+		MTU:             tools.Int64ToStringNegative(d.MTU.ValueInt64()),
+		MSS:             tools.Int64ToStringNegative(d.MSS.ValueInt64()),
+		IPv4Type:        api.SelectedMap(d.IPv4Type.ValueString()),
+		IPv4Address:     d.IPv4Address.ValueString(),
+		IPv4Gateway:     d.IPv4Gateway.ValueString(),
+		IPv6Type:        api.SelectedMap(d.IPv6Type.ValueString()),
+		IPv6Address:     d.IPv6Address.ValueString(),
+		IPv6Gateway:     d.IPv6Gateway.ValueString(),
+		Track6Interface: d.Track6Interface.ValueString(),
+		Track6PrefixId:  track6PrefixId,
+		BlockPrivate:    tools.BoolToString(d.BlockPrivate.ValueBool()),
+		BlockBogons:     tools.BoolToString(d.BlockBogons.ValueBool()),
+	}, nil
+}
+
+func convertAssignmentStructToSchema(d *interfaces.Assignment) (*assignmentResourceModel, error) {
+	// ipv4_type and ipv6_type are Optional+Computed with a default, so fall
+	// back to the schema default when the API responds with an empty string.
+	ipv4Type := "none"
+	if s := d.IPv4Type.String(); s != "" {
+		ipv4Type = s
+	}
+	ipv6Type := "none"
+	if s := d.IPv6Type.String(); s != "" {
+		ipv6Type = s
+	}
+
+	return &assignmentResourceModel{
+		Device:          types.StringValue(d.Device),
+		Description:     tools.StringOrNull(d.Description),
+		Enabled:         types.BoolValue(tools.StringToBool(d.Enabled)),
+		MTU:             types.Int64Value(tools.StringToInt64(d.MTU)),
+		MSS:             types.Int64Value(tools.StringToInt64(d.MSS)),
+		IPv4Type:        types.StringValue(ipv4Type),
+		IPv4Address:     tools.StringOrNull(d.IPv4Address),
+		IPv4Gateway:     tools.StringOrNull(d.IPv4Gateway),
+		IPv6Type:        types.StringValue(ipv6Type),
+		IPv6Address:     tools.StringOrNull(d.IPv6Address),
+		IPv6Gateway:     tools.StringOrNull(d.IPv6Gateway),
+		Track6Interface: tools.StringOrNull(d.Track6Interface),
+		Track6PrefixId:  tools.StringToInt64Null(d.Track6PrefixId),
+		BlockPrivate:    types.BoolValue(tools.StringToBool(d.BlockPrivate)),
+		BlockBogons:     types.BoolValue(tools.StringToBool(d.BlockBogons)),
+	}, nil
+}
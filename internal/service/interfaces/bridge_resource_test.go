@@ -0,0 +1,56 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesBridgeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBridgeResourceConfig("Bridge test", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_bridge.test", "id"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_bridge.test", "device", regexp.MustCompile(`^bridge[0-9]+$`)),
+					resource.TestCheckResourceAttr("opnsense_interfaces_bridge.test", "description", "Bridge test"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_bridge.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_bridge.test", "stp", "false"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_bridge.test", "stp_protocol", "rstp"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_bridge.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccBridgeResourceConfig("Updated bridge", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_bridge.test", "description", "Updated bridge"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_bridge.test", "stp", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBridgeResourceConfig(description string, stp bool) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_bridge" "test" {
+  description = %[1]q
+  members     = ["lan"]
+  stp         = %[2]t
+}
+`, description, stp)
+}
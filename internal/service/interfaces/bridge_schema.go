@@ -0,0 +1,196 @@
+package interfaces
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bridgeResourceModel describes the resource data model.
+type bridgeResourceModel struct {
+	Description  types.String `tfsdk:"description"`
+	Members      types.Set    `tfsdk:"members"`
+	LinkLocal    types.Bool   `tfsdk:"link_local"`
+	STP          types.Bool   `tfsdk:"stp"`
+	STPProtocol  types.String `tfsdk:"stp_protocol"`
+	MaxAddresses types.Int64  `tfsdk:"max_addresses"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+	Span         types.String `tfsdk:"span"`
+	Device       types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func bridgeResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Bridges connect multiple network interfaces at layer 2, so that they act as a single network segment.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "The interfaces to bridge, e.g. `[\"lan\", \"opt1\"]`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"link_local": schema.BoolAttribute{
+				MarkdownDescription: "Enable IPv6 link-local addresses on the bridge. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"stp": schema.BoolAttribute{
+				MarkdownDescription: "Enable the spanning tree protocol on the members, to prevent loops. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"stp_protocol": schema.StringAttribute{
+				MarkdownDescription: "The spanning tree protocol version. One of `rstp` or `stp`. Only used when `stp` is `true`. Defaults to `rstp`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("rstp"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("rstp", "stp"),
+				},
+			},
+			"max_addresses": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of addresses cached by the bridge. Set to `-1` to use the system default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout of cached addresses in seconds. Set to `0` to disable expiry, or `-1` to use the system default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+			},
+			"span": schema.StringAttribute{
+				MarkdownDescription: "Interface to send a copy of all frames passing the bridge to, for monitoring.",
+				Optional:            true,
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "The device name generated by OPNsense, e.g. `bridge0`. Use this to assign the bridge with `opnsense_interfaces_assignment`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the bridge.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func bridgeDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Bridges connect multiple network interfaces at layer 2, so that they act as a single network segment.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"members": dschema.SetAttribute{
+				MarkdownDescription: "The bridged interfaces.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"link_local": dschema.BoolAttribute{
+				MarkdownDescription: "Whether IPv6 link-local addresses are enabled on the bridge.",
+				Computed:            true,
+			},
+			"stp": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the spanning tree protocol is enabled.",
+				Computed:            true,
+			},
+			"stp_protocol": dschema.StringAttribute{
+				MarkdownDescription: "The spanning tree protocol version.",
+				Computed:            true,
+			},
+			"max_addresses": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of addresses cached by the bridge, `-1` for the system default.",
+				Computed:            true,
+			},
+			"timeout": dschema.Int64Attribute{
+				MarkdownDescription: "Timeout of cached addresses in seconds, `-1` for the system default.",
+				Computed:            true,
+			},
+			"span": dschema.StringAttribute{
+				MarkdownDescription: "Interface a copy of all frames is sent to.",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "The device name of the bridge, e.g. `bridge0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertBridgeSchemaToStruct(d *bridgeResourceModel) (*interfaces.Bridge, error) {
+	return &interfaces.Bridge{
+		Description:  d.Description.ValueString(),
+		Members:      api.SelectedMapList(tools.SetToStringSlice(d.Members)),
+		LinkLocal:    tools.BoolToString(d.LinkLocal.ValueBool()),
+		STP:          tools.BoolToString(d.STP.ValueBool()),
+		STPProtocol:  api.SelectedMap(d.STPProtocol.ValueString()),
+		MaxAddresses: tools.Int64ToStringNegative(d.MaxAddresses.ValueInt64()),
+		Timeout:      tools.Int64ToStringNegative(d.Timeout.ValueInt64()),
+		Span:         api.SelectedMap(d.Span.ValueString()),
+	}, nil
+}
+
+func convertBridgeStructToSchema(d *interfaces.Bridge) (*bridgeResourceModel, error) {
+	// stp_protocol is Optional+Computed with a default, so fall back to the
+	// schema default when the API responds with an empty string.
+	stpProtocol := "rstp"
+	if s := d.STPProtocol.String(); s != "" {
+		stpProtocol = s
+	}
+
+	return &bridgeResourceModel{
+		Description:  tools.StringOrNull(d.Description),
+		Members:      tools.StringSliceToSet(d.Members),
+		LinkLocal:    types.BoolValue(tools.StringToBool(d.LinkLocal)),
+		STP:          types.BoolValue(tools.StringToBool(d.STP)),
+		STPProtocol:  types.StringValue(stpProtocol),
+		MaxAddresses: types.Int64Value(tools.StringToInt64(d.MaxAddresses)),
+		Timeout:      types.Int64Value(tools.StringToInt64(d.Timeout)),
+		Span:         tools.StringOrNull(d.Span.String()),
+		Device:       types.StringValue(d.Device),
+	}, nil
+}
@@ -0,0 +1,124 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &carpMaintenanceAction{}
+var _ action.ActionWithConfigure = &carpMaintenanceAction{}
+var _ action.ActionWithConfigValidators = &carpMaintenanceAction{}
+
+func newCarpMaintenanceAction() action.Action {
+	return &carpMaintenanceAction{}
+}
+
+type carpMaintenanceAction struct {
+	client opnsense.Client
+}
+
+type carpMaintenanceActionModel struct {
+	MaintenanceMode types.Bool `tfsdk:"maintenance_mode"`
+	Enabled         types.Bool `tfsdk:"enabled"`
+}
+
+func (a *carpMaintenanceAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_carp_maintenance"
+}
+
+func (a *carpMaintenanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Changes the CARP status of this firewall, e.g. to hand over the CARP virtual IPs to the other cluster member before a firmware upgrade. The resulting status is reported after the change; read it with the `opnsense_carp_status` data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"maintenance_mode": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to enter persistent CARP maintenance mode, which demotes this firewall so that the other cluster member becomes master, and persists across reboots. Set to `false` to leave it.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Set to `false` to temporarily disable CARP on this firewall, until it is enabled again or the firewall reboots. Set to `true` to enable it.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *carpMaintenanceAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("maintenance_mode"),
+			path.MatchRoot("enabled"),
+		),
+	}
+}
+
+func (a *carpMaintenanceAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = opnsense.NewClient(apiClient)
+}
+
+func (a *carpMaintenanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data carpMaintenanceActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.MaintenanceMode.IsNull() {
+		if err := a.client.Diagnostics().SetCarpMaintenanceMode(ctx, data.MaintenanceMode.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to set carp maintenance mode, got error: %s", err))
+			return
+		}
+	}
+
+	if !data.Enabled.IsNull() {
+		if err := a.client.Diagnostics().SetCarpEnabled(ctx, data.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to set carp status, got error: %s", err))
+			return
+		}
+	}
+
+	status, err := a.client.Diagnostics().CarpStatus(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read carp status, got error: %s", err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CARP enabled: %t, maintenance mode: %t, demotion counter: %s",
+			tools.StringToBool(status.Enabled), tools.StringToBool(status.MaintenanceMode), status.Demotion),
+	})
+	for _, v := range status.Vips {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("VHID %s on %s (%s): %s", v.VHID, v.Interface, v.Subnet, v.Status),
+		})
+	}
+
+	tflog.Trace(ctx, "invoked carp maintenance action", map[string]any{"maintenance_mode": status.MaintenanceMode, "enabled": status.Enabled})
+}
@@ -0,0 +1,94 @@
+package interfaces_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Actions are only supported in Terraform 1.14 and later.
+var version1_14_0 = version.Must(version.NewVersion("1.14.0"))
+
+func TestAccCarpMaintenanceAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(version1_14_0)},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Enter maintenance mode, then leave it again
+			{
+				Config: testAccCarpMaintenanceActionConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opnsense_carp_status.test", "maintenance_mode", "true"),
+				),
+			},
+			{
+				Config: testAccCarpMaintenanceActionConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opnsense_carp_status.test", "maintenance_mode", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCarpMaintenanceAction_MissingSetting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(version1_14_0)},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+action "opnsense_carp_maintenance" "test" {
+  config {}
+}
+
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.opnsense_carp_maintenance.test]
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccCarpMaintenanceActionConfig(maintenanceMode bool) string {
+	mode := "false"
+	if maintenanceMode {
+		mode = "true"
+	}
+
+	return `
+action "opnsense_carp_maintenance" "test" {
+  config {
+    maintenance_mode = ` + mode + `
+  }
+}
+
+resource "terraform_data" "test" {
+  input = ` + mode + `
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.opnsense_carp_maintenance.test]
+    }
+  }
+}
+
+data "opnsense_carp_status" "test" {
+  depends_on = [terraform_data.test]
+}
+`
+}
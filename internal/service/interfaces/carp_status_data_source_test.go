@@ -0,0 +1,45 @@
+package interfaces_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCarpStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCarpStatusDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opnsense_carp_status.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.opnsense_carp_status.test", "maintenance_mode", "false"),
+					resource.TestCheckResourceAttrSet("data.opnsense_carp_status.test", "demotion_counter"),
+					resource.TestCheckResourceAttr("data.opnsense_carp_status.test", "vips.#", "1"),
+					resource.TestCheckResourceAttr("data.opnsense_carp_status.test", "vips.0.vhid", "211"),
+					resource.TestCheckResourceAttrSet("data.opnsense_carp_status.test", "vips.0.address"),
+					resource.TestCheckResourceAttrSet("data.opnsense_carp_status.test", "vips.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCarpStatusDataSourceConfig() string {
+	return `
+resource "opnsense_interfaces_vip" "test" {
+  mode      = "carp"
+  interface = "wan"
+  network   = "192.168.2.30/32"
+  vhid      = 211
+  password  = "carp-status"
+}
+
+data "opnsense_carp_status" "test" {
+  depends_on = [opnsense_interfaces_vip.test]
+}
+`
+}
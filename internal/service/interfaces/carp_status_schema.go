@@ -0,0 +1,131 @@
+package interfaces
+
+import (
+	"context"
+	"sort"
+
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type carpStatusDataSourceModel struct {
+	Enabled         types.Bool  `tfsdk:"enabled"`
+	MaintenanceMode types.Bool  `tfsdk:"maintenance_mode"`
+	DemotionCounter types.Int64 `tfsdk:"demotion_counter"`
+	Vips            types.List  `tfsdk:"vips"`
+}
+
+type carpStatusVipModel struct {
+	Interface types.String `tfsdk:"interface"`
+	VHID      types.Int64  `tfsdk:"vhid"`
+	Address   types.String `tfsdk:"address"`
+	Status    types.String `tfsdk:"status"`
+	AdvBase   types.Int64  `tfsdk:"advbase"`
+	AdvSkew   types.Int64  `tfsdk:"advskew"`
+}
+
+var carpStatusVipAttrTypes = map[string]attr.Type{
+	"interface": types.StringType,
+	"vhid":      types.Int64Type,
+	"address":   types.StringType,
+	"status":    types.StringType,
+	"advbase":   types.Int64Type,
+	"advskew":   types.Int64Type,
+}
+
+func carpStatusDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Reads the live CARP status of this firewall, including the state of each CARP virtual IP (see `opnsense_interfaces_vip`). Use the `opnsense_carp_maintenance` action to change it.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether CARP is enabled. CARP is disabled temporarily, until it is enabled again or the firewall reboots.",
+				Computed:            true,
+			},
+			"maintenance_mode": schema.BoolAttribute{
+				MarkdownDescription: "Whether persistent CARP maintenance mode is active. In maintenance mode, the demotion counter is raised so that the other cluster member takes over, and this persists across reboots.",
+				Computed:            true,
+			},
+			"demotion_counter": schema.Int64Attribute{
+				MarkdownDescription: "The CARP demotion counter. A higher value makes this firewall less preferred as master, e.g. when an interface is down or maintenance mode is active.",
+				Computed:            true,
+			},
+			"vips": schema.ListNestedAttribute{
+				MarkdownDescription: "The CARP virtual IPs, sorted by interface and VHID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "The interface of the virtual IP.",
+							Computed:            true,
+						},
+						"vhid": schema.Int64Attribute{
+							MarkdownDescription: "The virtual host ID of the CARP group.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The address of the virtual IP.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The CARP state of the virtual IP on this firewall. One of `MASTER`, `BACKUP`, `INIT` or `DISABLED`.",
+							Computed:            true,
+						},
+						"advbase": schema.Int64Attribute{
+							MarkdownDescription: "The base interval in seconds at which CARP advertisements are sent.",
+							Computed:            true,
+						},
+						"advskew": schema.Int64Attribute{
+							MarkdownDescription: "The skew added to `advbase` for CARP advertisements.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertCarpStatusStructToSchema(d *diagnostics.CarpStatus) *carpStatusDataSourceModel {
+	var vips []carpStatusVipModel
+	for _, v := range d.Vips {
+		vips = append(vips, carpStatusVipModel{
+			Interface: types.StringValue(v.Interface),
+			VHID:      types.Int64Value(tools.StringToInt64(v.VHID)),
+			Address:   types.StringValue(v.Subnet),
+			Status:    types.StringValue(v.Status),
+			AdvBase:   types.Int64Value(tools.StringToInt64(v.AdvBase)),
+			AdvSkew:   types.Int64Value(tools.StringToInt64(v.AdvSkew)),
+		})
+	}
+	sort.Slice(vips, func(i, j int) bool {
+		if vips[i].Interface.ValueString() != vips[j].Interface.ValueString() {
+			return vips[i].Interface.ValueString() < vips[j].Interface.ValueString()
+		}
+		return vips[i].VHID.ValueInt64() < vips[j].VHID.ValueInt64()
+	})
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: carpStatusVipAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(vips) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: carpStatusVipAttrTypes},
+			vips,
+		)
+	}
+
+	return &carpStatusDataSourceModel{
+		Enabled:         types.BoolValue(tools.StringToBool(d.Enabled)),
+		MaintenanceMode: types.BoolValue(tools.StringToBool(d.MaintenanceMode)),
+		DemotionCounter: types.Int64Value(tools.StringToInt64(d.Demotion)),
+		Vips:            v,
+	}
+}
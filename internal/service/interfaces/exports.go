@@ -2,22 +2,47 @@ package interfaces
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 func Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		newAssignmentResource,
+		newBridgeResource,
+		newGifResource,
+		newGreResource,
+		newLaggResource,
+		newLoopbackResource,
+		newNeighborResource,
 		newVipResource,
 		newVlanResource,
+		newVxlanResource,
 	}
 }
 
 func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		newAssignmentDataSource,
+		newBridgeDataSource,
+		newGifDataSource,
+		newGreDataSource,
+		newLaggDataSource,
+		newLoopbackDataSource,
+		newNeighborDataSource,
 		newVipDataSource,
 		newVlanDataSource,
+		newVxlanDataSource,
 		newOverviewInterfaceDataSource,
 		newOverviewAllDataSource,
+		newCarpStatusDataSource,
+	}
+}
+
+func Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		newCarpMaintenanceAction,
 	}
 }
@@ -0,0 +1,59 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesGifResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGifResourceConfig("GIF test", "198.51.100.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_gif.test", "id"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_gif.test", "device", regexp.MustCompile(`^gif[0-9]+$`)),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gif.test", "description", "GIF test"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gif.test", "remote_address", "198.51.100.1"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gif.test", "tunnel_remote_net", "64"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gif.test", "ecn", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_gif.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGifResourceConfig("Updated GIF", "198.51.100.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_gif.test", "description", "Updated GIF"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gif.test", "remote_address", "198.51.100.2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGifResourceConfig(description, remote string) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_gif" "test" {
+  description           = %[1]q
+  local_address         = "wan"
+  remote_address        = %[2]q
+  tunnel_local_address  = "2001:db8::2"
+  tunnel_remote_address = "2001:db8::1"
+  tunnel_remote_net     = 64
+}
+`, description, remote)
+}
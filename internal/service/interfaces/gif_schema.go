@@ -0,0 +1,181 @@
+package interfaces
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gifResourceModel describes the resource data model.
+type gifResourceModel struct {
+	Description         types.String `tfsdk:"description"`
+	LocalAddress        types.String `tfsdk:"local_address"`
+	RemoteAddress       types.String `tfsdk:"remote_address"`
+	TunnelLocalAddress  types.String `tfsdk:"tunnel_local_address"`
+	TunnelRemoteAddress types.String `tfsdk:"tunnel_remote_address"`
+	TunnelRemoteNet     types.Int64  `tfsdk:"tunnel_remote_net"`
+	ECN                 types.Bool   `tfsdk:"ecn"`
+	OuterSourceFilter   types.Bool   `tfsdk:"outer_source_filter"`
+	Device              types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func gifResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "GIF (generic tunnel interface) tunnels encapsulate IPv4 or IPv6 traffic in IPv4 or IPv6, e.g. for IPv6 tunnel brokers.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"local_address": schema.StringAttribute{
+				MarkdownDescription: "The interface or virtual IP whose address is used as the local endpoint of the tunnel, e.g. `wan`.",
+				Required:            true,
+			},
+			"remote_address": schema.StringAttribute{
+				MarkdownDescription: "The address of the remote endpoint of the tunnel.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"tunnel_local_address": schema.StringAttribute{
+				MarkdownDescription: "The local address inside the tunnel.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"tunnel_remote_address": schema.StringAttribute{
+				MarkdownDescription: "The remote address inside the tunnel.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"tunnel_remote_net": schema.Int64Attribute{
+				MarkdownDescription: "The prefix length of the network inside the tunnel. Defaults to `32`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(32),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+				},
+			},
+			"ecn": schema.BoolAttribute{
+				MarkdownDescription: "Enable ECN friendly behavior, which copies the ECN bits of the inner packets to the outer packets. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"outer_source_filter": schema.BoolAttribute{
+				MarkdownDescription: "Drop packets whose outer source address does not match the configured remote address. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "The device name generated by OPNsense, e.g. `gif0`. Use this to assign the tunnel with `opnsense_interfaces_assignment`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the GIF tunnel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func gifDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "GIF (generic tunnel interface) tunnels encapsulate IPv4 or IPv6 traffic in IPv4 or IPv6, e.g. for IPv6 tunnel brokers.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"local_address": dschema.StringAttribute{
+				MarkdownDescription: "The interface or virtual IP used as the local endpoint of the tunnel.",
+				Computed:            true,
+			},
+			"remote_address": dschema.StringAttribute{
+				MarkdownDescription: "The address of the remote endpoint of the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_local_address": dschema.StringAttribute{
+				MarkdownDescription: "The local address inside the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_remote_address": dschema.StringAttribute{
+				MarkdownDescription: "The remote address inside the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_remote_net": dschema.Int64Attribute{
+				MarkdownDescription: "The prefix length of the network inside the tunnel.",
+				Computed:            true,
+			},
+			"ecn": dschema.BoolAttribute{
+				MarkdownDescription: "Whether ECN friendly behavior is enabled.",
+				Computed:            true,
+			},
+			"outer_source_filter": dschema.BoolAttribute{
+				MarkdownDescription: "Whether packets are dropped if their outer source address does not match the remote address.",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "The device name of the tunnel, e.g. `gif0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertGifSchemaToStruct(d *gifResourceModel) (*interfaces.Gif, error) {
+	return &interfaces.Gif{
+		Description:         d.Description.ValueString(),
+		LocalAddress:        api.SelectedMap(d.LocalAddress.ValueString()),
+		RemoteAddress:       d.RemoteAddress.ValueString(),
+		TunnelLocalAddress:  d.TunnelLocalAddress.ValueString(),
+		TunnelRemoteAddress: d.TunnelRemoteAddress.ValueString(),
+		TunnelRemoteNet:     api.SelectedMap(tools.Int64ToString(d.TunnelRemoteNet.ValueInt64())),
+		ECN:                 tools.BoolToString(d.ECN.ValueBool()),
+		OuterSourceFilter:   tools.BoolToString(d.OuterSourceFilter.ValueBool()),
+	}, nil
+}
+
+func convertGifStructToSchema(d *interfaces.Gif) (*gifResourceModel, error) {
+	return &gifResourceModel{
+		Description:         tools.StringOrNull(d.Description),
+		LocalAddress:        types.StringValue(d.LocalAddress.String()),
+		RemoteAddress:       types.StringValue(d.RemoteAddress),
+		TunnelLocalAddress:  types.StringValue(d.TunnelLocalAddress),
+		TunnelRemoteAddress: types.StringValue(d.TunnelRemoteAddress),
+		TunnelRemoteNet:     types.Int64Value(tools.StringToInt64(d.TunnelRemoteNet.String())),
+		ECN:                 types.BoolValue(tools.StringToBool(d.ECN)),
+		OuterSourceFilter:   types.BoolValue(tools.StringToBool(d.OuterSourceFilter)),
+		Device:              types.StringValue(d.Device),
+	}, nil
+}
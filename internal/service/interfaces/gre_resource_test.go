@@ -0,0 +1,58 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesGreResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGreResourceConfig("GRE test", "198.51.100.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_gre.test", "id"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_gre.test", "device", regexp.MustCompile(`^gre[0-9]+$`)),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gre.test", "description", "GRE test"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gre.test", "remote_address", "198.51.100.1"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gre.test", "tunnel_remote_net", "30"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_gre.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGreResourceConfig("Updated GRE", "198.51.100.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_gre.test", "description", "Updated GRE"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_gre.test", "remote_address", "198.51.100.2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGreResourceConfig(description, remote string) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_gre" "test" {
+  description           = %[1]q
+  local_address         = "wan"
+  remote_address        = %[2]q
+  tunnel_local_address  = "10.255.0.2"
+  tunnel_remote_address = "10.255.0.1"
+  tunnel_remote_net     = 30
+}
+`, description, remote)
+}
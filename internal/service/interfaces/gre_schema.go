@@ -0,0 +1,154 @@
+package interfaces
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// greResourceModel describes the resource data model.
+type greResourceModel struct {
+	Description         types.String `tfsdk:"description"`
+	LocalAddress        types.String `tfsdk:"local_address"`
+	RemoteAddress       types.String `tfsdk:"remote_address"`
+	TunnelLocalAddress  types.String `tfsdk:"tunnel_local_address"`
+	TunnelRemoteAddress types.String `tfsdk:"tunnel_remote_address"`
+	TunnelRemoteNet     types.Int64  `tfsdk:"tunnel_remote_net"`
+	Device              types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func greResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "GRE (Generic Routing Encapsulation) tunnels encapsulate IPv4 and IPv6 traffic between two endpoints.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"local_address": schema.StringAttribute{
+				MarkdownDescription: "The interface or virtual IP whose address is used as the local endpoint of the tunnel, e.g. `wan`.",
+				Required:            true,
+			},
+			"remote_address": schema.StringAttribute{
+				MarkdownDescription: "The address of the remote endpoint of the tunnel.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"tunnel_local_address": schema.StringAttribute{
+				MarkdownDescription: "The local address inside the tunnel.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"tunnel_remote_address": schema.StringAttribute{
+				MarkdownDescription: "The remote address inside the tunnel.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"tunnel_remote_net": schema.Int64Attribute{
+				MarkdownDescription: "The prefix length of the network inside the tunnel. Defaults to `32`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(32),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+				},
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "The device name generated by OPNsense, e.g. `gre0`. Use this to assign the tunnel with `opnsense_interfaces_assignment`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the GRE tunnel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func greDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "GRE (Generic Routing Encapsulation) tunnels encapsulate IPv4 and IPv6 traffic between two endpoints.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"local_address": dschema.StringAttribute{
+				MarkdownDescription: "The interface or virtual IP used as the local endpoint of the tunnel.",
+				Computed:            true,
+			},
+			"remote_address": dschema.StringAttribute{
+				MarkdownDescription: "The address of the remote endpoint of the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_local_address": dschema.StringAttribute{
+				MarkdownDescription: "The local address inside the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_remote_address": dschema.StringAttribute{
+				MarkdownDescription: "The remote address inside the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_remote_net": dschema.Int64Attribute{
+				MarkdownDescription: "The prefix length of the network inside the tunnel.",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "The device name of the tunnel, e.g. `gre0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertGreSchemaToStruct(d *greResourceModel) (*interfaces.Gre, error) {
+	return &interfaces.Gre{
+		Description:         d.Description.ValueString(),
+		LocalAddress:        api.SelectedMap(d.LocalAddress.ValueString()),
+		RemoteAddress:       d.RemoteAddress.ValueString(),
+		TunnelLocalAddress:  d.TunnelLocalAddress.ValueString(),
+		TunnelRemoteAddress: d.TunnelRemoteAddress.ValueString(),
+		TunnelRemoteNet:     api.SelectedMap(tools.Int64ToString(d.TunnelRemoteNet.ValueInt64())),
+	}, nil
+}
+
+func convertGreStructToSchema(d *interfaces.Gre) (*greResourceModel, error) {
+	return &greResourceModel{
+		Description:         tools.StringOrNull(d.Description),
+		LocalAddress:        types.StringValue(d.LocalAddress.String()),
+		RemoteAddress:       types.StringValue(d.RemoteAddress),
+		TunnelLocalAddress:  types.StringValue(d.TunnelLocalAddress),
+		TunnelRemoteAddress: types.StringValue(d.TunnelRemoteAddress),
+		TunnelRemoteNet:     types.Int64Value(tools.StringToInt64(d.TunnelRemoteNet.String())),
+		Device:              types.StringValue(d.Device),
+	}, nil
+}
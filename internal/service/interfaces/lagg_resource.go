@@ -0,0 +1,210 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &laggResource{}
+var _ resource.ResourceWithConfigure = &laggResource{}
+var _ resource.ResourceWithImportState = &laggResource{}
+
+func newLaggResource() resource.Resource {
+	return &laggResource{}
+}
+
+// laggResource defines the resource implementation.
+type laggResource struct {
+	client opnsense.Client
+}
+
+func (r *laggResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interfaces_lagg"
+}
+
+func (r *laggResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = laggResourceSchema()
+}
+
+func (r *laggResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *laggResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *laggResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	lagg, err := convertLaggSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse lagg, got error: %s", err))
+		return
+	}
+
+	// Add LAGG to OPNsense interfaces
+	id, err := r.client.Interfaces().AddLagg(ctx, lagg)
+	if err != nil {
+		if id != "" {
+			data.Id = types.StringValue(id)
+
+			if readModel := readBackCreated(ctx, id, r.client.Interfaces().GetLagg, convertLaggStructToSchema); readModel != nil {
+				readModel.Id = data.Id
+				data = readModel
+			} else {
+				// The device name is generated by OPNsense
+				data.Device = types.StringValue("")
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create lagg, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Read back the device name generated by OPNsense
+	readStruct, err := r.client.Interfaces().GetLagg(ctx, id)
+	if err != nil {
+		data.Device = types.StringValue("")
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read lagg device, got error: %s", err))
+		return
+	}
+	data.Device = types.StringValue(readStruct.Device)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *laggResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *laggResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get LAGG from OPNsense core API
+	lagg, err := r.client.Interfaces().GetLagg(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("lagg not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read lagg, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	laggModel, err := convertLaggStructToSchema(lagg)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read lagg, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	laggModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &laggModel)...)
+}
+
+func (r *laggResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *laggResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	lagg, err := convertLaggSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse lagg, got error: %s", err))
+		return
+	}
+
+	// Update LAGG in OPNsense core
+	err = r.client.Interfaces().UpdateLagg(ctx, data.Id.ValueString(), lagg)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update lagg, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *laggResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *laggResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Interfaces().DeleteLagg(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete lagg, got error: %s", err))
+		return
+	}
+}
+
+func (r *laggResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
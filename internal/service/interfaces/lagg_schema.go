@@ -0,0 +1,228 @@
+package interfaces
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// laggResourceModel describes the resource data model.
+type laggResourceModel struct {
+	Description     types.String `tfsdk:"description"`
+	Members         types.Set    `tfsdk:"members"`
+	PrimaryMember   types.String `tfsdk:"primary_member"`
+	Protocol        types.String `tfsdk:"protocol"`
+	Hash            types.Set    `tfsdk:"hash"`
+	LACPFastTimeout types.Bool   `tfsdk:"lacp_fast_timeout"`
+	LACPStrict      types.String `tfsdk:"lacp_strict"`
+	UseFlowId       types.String `tfsdk:"use_flowid"`
+	MTU             types.Int64  `tfsdk:"mtu"`
+	Device          types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func laggResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Link aggregation (LAGG) combines multiple network interfaces into a single logical interface, for failover or to increase bandwidth.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "The interfaces to aggregate, e.g. `[\"vtnet1\", \"vtnet2\"]`. Members must not be assigned to an interface.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"primary_member": schema.StringAttribute{
+				MarkdownDescription: "The member used as the primary port, which also provides the MAC address of the LAGG. Must be one of `members`. The first member is used if omitted.",
+				Optional:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The aggregation protocol. One of `none`, `lacp`, `failover`, `fec`, `loadbalance` or `roundrobin`. Defaults to `lacp`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("lacp"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "lacp", "failover", "fec", "loadbalance", "roundrobin"),
+				},
+			},
+			"hash": schema.SetAttribute{
+				MarkdownDescription: "The packet layers used to select the member to send traffic on, any of `l2`, `l3` and `l4`. Only used when `protocol` is `lacp` or `loadbalance`. Defaults to `[\"l2\", \"l3\", \"l4\"]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default: setdefault.StaticValue(
+					tools.StringSliceToSet([]string{"l2", "l3", "l4"}),
+				),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("l2", "l3", "l4")),
+				},
+			},
+			"lacp_fast_timeout": schema.BoolAttribute{
+				MarkdownDescription: "Send LACP packets every second instead of every 30 seconds. Only used when `protocol` is `lacp`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"lacp_strict": schema.StringAttribute{
+				MarkdownDescription: "Enable LACP strict mode, which only activates members after the partner responds. One of `default` (the system default), `yes` or `no`. Only used when `protocol` is `lacp`. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "yes", "no"),
+				},
+			},
+			"use_flowid": schema.StringAttribute{
+				MarkdownDescription: "Use the RSS hash of the network card to select the member, instead of computing the hash. One of `default` (the system default), `yes` or `no`. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "yes", "no"),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "MTU of the LAGG and its members. Set to `-1` to use the MTU of the members. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(576, 65535),
+					),
+				},
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "The device name generated by OPNsense, e.g. `lagg0`. Use this to assign the LAGG with `opnsense_interfaces_assignment`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the LAGG.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func laggDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Link aggregation (LAGG) combines multiple network interfaces into a single logical interface, for failover or to increase bandwidth.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"members": dschema.SetAttribute{
+				MarkdownDescription: "The aggregated interfaces.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"primary_member": dschema.StringAttribute{
+				MarkdownDescription: "The member used as the primary port.",
+				Computed:            true,
+			},
+			"protocol": dschema.StringAttribute{
+				MarkdownDescription: "The aggregation protocol.",
+				Computed:            true,
+			},
+			"hash": dschema.SetAttribute{
+				MarkdownDescription: "The packet layers used to select the member to send traffic on.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"lacp_fast_timeout": dschema.BoolAttribute{
+				MarkdownDescription: "Whether LACP packets are sent every second.",
+				Computed:            true,
+			},
+			"lacp_strict": dschema.StringAttribute{
+				MarkdownDescription: "LACP strict mode, one of `default`, `yes` or `no`.",
+				Computed:            true,
+			},
+			"use_flowid": dschema.StringAttribute{
+				MarkdownDescription: "Whether the RSS hash is used to select the member, one of `default`, `yes` or `no`.",
+				Computed:            true,
+			},
+			"mtu": dschema.Int64Attribute{
+				MarkdownDescription: "MTU of the LAGG, `-1` if the MTU of the members is used.",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "The device name of the LAGG, e.g. `lagg0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertLaggSchemaToStruct(d *laggResourceModel) (*interfaces.Lagg, error) {
+	return &interfaces.Lagg{
+		Description:     d.Description.ValueString(),
+		Members:         api.SelectedMapList(tools.SetToStringSlice(d.Members)),
+		PrimaryMember:   api.SelectedMap(d.PrimaryMember.ValueString()),
+		Protocol:        api.SelectedMap(d.Protocol.ValueString()),
+		Hash:            api.SelectedMapList(tools.SetToStringSlice(d.Hash)),
+		LACPFastTimeout: tools.BoolToString(d.LACPFastTimeout.ValueBool()),
+		LACPStrict:      api.SelectedMap(d.LACPStrict.ValueString()),
+		UseFlowId:       api.SelectedMap(d.UseFlowId.ValueString()),
+		MTU:             tools.Int64ToStringNegative(d.MTU.ValueInt64()),
+	}, nil
+}
+
+func convertLaggStructToSchema(d *interfaces.Lagg) (*laggResourceModel, error) {
+	// lacp_strict and use_flowid are Optional+Computed with a default, so
+	// fall back to the schema default when the API responds with an empty
+	// string.
+	lacpStrict := "default"
+	if s := d.LACPStrict.String(); s != "" {
+		lacpStrict = s
+	}
+	useFlowId := "default"
+	if s := d.UseFlowId.String(); s != "" {
+		useFlowId = s
+	}
+
+	return &laggResourceModel{
+		Description:     tools.StringOrNull(d.Description),
+		Members:         tools.StringSliceToSet(d.Members),
+		PrimaryMember:   tools.StringOrNull(d.PrimaryMember.String()),
+		Protocol:        types.StringValue(d.Protocol.String()),
+		Hash:            tools.StringSliceToSet(d.Hash),
+		LACPFastTimeout: types.BoolValue(tools.StringToBool(d.LACPFastTimeout)),
+		LACPStrict:      types.StringValue(lacpStrict),
+		UseFlowId:       types.StringValue(useFlowId),
+		MTU:             types.Int64Value(tools.StringToInt64(d.MTU)),
+		Device:          types.StringValue(d.Device),
+	}, nil
+}
@@ -0,0 +1,50 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesLoopbackResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLoopbackResourceConfig("Loopback test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_loopback.test", "id"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_loopback.test", "device", regexp.MustCompile(`^lo[0-9]+$`)),
+					resource.TestCheckResourceAttr("opnsense_interfaces_loopback.test", "description", "Loopback test"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_loopback.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccLoopbackResourceConfig("Updated loopback"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_loopback.test", "description", "Updated loopback"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccLoopbackResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_loopback" "test" {
+  description = %[1]q
+}
+`, description)
+}
@@ -0,0 +1,80 @@
+package interfaces
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// loopbackResourceModel describes the resource data model.
+type loopbackResourceModel struct {
+	Description types.String `tfsdk:"description"`
+	Device      types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func loopbackResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Loopback interfaces are virtual interfaces that are always up, e.g. to hold addresses for routing protocols or services that should not depend on a physical link.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "The device name generated by OPNsense, e.g. `lo1`. Use this to assign the loopback with `opnsense_interfaces_assignment`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the loopback.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func loopbackDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Loopback interfaces are virtual interfaces that are always up, e.g. to hold addresses for routing protocols or services that should not depend on a physical link.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "The device name of the loopback, e.g. `lo1`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertLoopbackSchemaToStruct(d *loopbackResourceModel) (*interfaces.Loopback, error) {
+	return &interfaces.Loopback{
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertLoopbackStructToSchema(d *interfaces.Loopback) (*loopbackResourceModel, error) {
+	return &loopbackResourceModel{
+		Description: tools.StringOrNull(d.Description),
+		Device:      types.StringValue(d.Device),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesNeighborResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNeighborResourceConfig("00:11:22:aa:bb:cc", "192.168.1.50", "Neighbor test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_neighbor.test", "id"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_neighbor.test", "ether_address", "00:11:22:aa:bb:cc"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_neighbor.test", "ip_address", "192.168.1.50"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_neighbor.test", "description", "Neighbor test"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_neighbor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNeighborResourceConfig("00:11:22:aa:bb:cd", "2001:db8::50", "Updated neighbor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_neighbor.test", "ether_address", "00:11:22:aa:bb:cd"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_neighbor.test", "ip_address", "2001:db8::50"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_neighbor.test", "description", "Updated neighbor"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNeighborResourceConfig(etherAddress, ipAddress, description string) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_neighbor" "test" {
+  ether_address = %[1]q
+  ip_address    = %[2]q
+  description   = %[3]q
+}
+`, etherAddress, ipAddress, description)
+}
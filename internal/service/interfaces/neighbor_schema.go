@@ -0,0 +1,103 @@
+package interfaces
+
+import (
+	"regexp"
+
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// neighborResourceModel describes the resource data model.
+type neighborResourceModel struct {
+	EtherAddress types.String `tfsdk:"ether_address"`
+	IpAddress    types.String `tfsdk:"ip_address"`
+	Description  types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func neighborResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Static neighbors add permanent ARP (IPv4) or NDP (IPv6) entries, which pin an IP address to a hardware address, e.g. to prevent spoofing.",
+
+		Attributes: map[string]schema.Attribute{
+			"ether_address": schema.StringAttribute{
+				MarkdownDescription: "Hardware (MAC) address of the neighbor in lowercase, e.g. `00:11:22:aa:bb:cc`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([0-9a-f]{2}:){5}[0-9a-f]{2}$`),
+						"must be a lowercase MAC address, e.g. 00:11:22:aa:bb:cc",
+					),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IPv4 or IPv6 address of the neighbor.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the neighbor.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func neighborDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Static neighbors add permanent ARP (IPv4) or NDP (IPv6) entries, which pin an IP address to a hardware address, e.g. to prevent spoofing.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"ether_address": dschema.StringAttribute{
+				MarkdownDescription: "Hardware (MAC) address of the neighbor.",
+				Computed:            true,
+			},
+			"ip_address": dschema.StringAttribute{
+				MarkdownDescription: "IPv4 or IPv6 address of the neighbor.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertNeighborSchemaToStruct(d *neighborResourceModel) (*interfaces.Neighbor, error) {
+	return &interfaces.Neighbor{
+		EtherAddress: d.EtherAddress.ValueString(),
+		IpAddress:    d.IpAddress.ValueString(),
+		Description:  d.Description.ValueString(),
+	}, nil
+}
+
+func convertNeighborStructToSchema(d *interfaces.Neighbor) (*neighborResourceModel, error) {
+	return &neighborResourceModel{
+		EtherAddress: types.StringValue(d.EtherAddress),
+		IpAddress:    types.StringValue(d.IpAddress),
+		Description:  tools.StringOrNull(d.Description),
+	}, nil
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// readBackCreated reads back an object that was created even though its
+// creation returned an error, so state captures API-normalised values
+// (defaults, sorting, trimming). It returns nil if the read-back fails, in
+// which case the caller saves the planned values instead, so the upstream
+// object isn't orphaned.
+func readBackCreated[S, M any](ctx context.Context, id string, get func(context.Context, string) (*S, error), convert func(*S) (*M, error)) *M {
+	readStruct, err := get(ctx, id)
+	if err != nil {
+		return nil
+	}
+
+	readModel, err := convert(readStruct)
+	if err != nil {
+		return nil
+	}
+	return readModel
+}
@@ -49,7 +49,7 @@ func (d *vipDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 }
 
 func (d *vipDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *vipResourceModel
+	var data *vipModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -8,6 +8,8 @@ import (
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
 	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +20,8 @@ import (
 var _ resource.Resource = &vipResource{}
 var _ resource.ResourceWithConfigure = &vipResource{}
 var _ resource.ResourceWithImportState = &vipResource{}
+var _ resource.ResourceWithConfigValidators = &vipResource{}
+var _ resource.ResourceWithModifyPlan = &vipResource{}
 
 func newVipResource() resource.Resource {
 	return &vipResource{}
@@ -36,6 +40,33 @@ func (r *vipResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	resp.Schema = vipResourceSchema()
 }
 
+func (r *vipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	configValidators := []resource.ConfigValidator{
+		// vhid and password must be set when mode = "carp"
+		validators.RequiredWhenStringEqualsOneOf(
+			path.MatchRoot("vhid"),
+			path.MatchRoot("mode"),
+			[]string{"carp"},
+		),
+		validators.RequiredWhenStringEqualsOneOf(
+			path.MatchRoot("password"),
+			path.MatchRoot("mode"),
+			[]string{"carp"},
+		),
+	}
+
+	// CARP settings only apply when mode = "carp"
+	for _, attribute := range []string{"vhid", "password", "password_version", "peer", "peer6"} {
+		configValidators = append(configValidators, validators.RequiresStringEqualsOneOf(
+			path.MatchRoot(attribute),
+			path.MatchRoot("mode"),
+			[]string{"carp"},
+		))
+	}
+
+	return configValidators
+}
+
 func (r *vipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -60,6 +91,9 @@ func (r *vipResource) Create(ctx context.Context, req resource.CreateRequest, re
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// Write-only password is only present in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &data.Password)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -78,14 +112,9 @@ func (r *vipResource) Create(ctx context.Context, req resource.CreateRequest, re
 		if id != "" {
 			data.Id = types.StringValue(id)
 
-			// Read back so state captures API-normalised values (defaults,
-			// sorting, trimming); fall back to plan-only state if the
-			// read-back fails so the upstream resource isn't orphaned.
-			if readStruct, readErr := r.client.Interfaces().GetVip(ctx, id); readErr == nil {
-				if readModel, convErr := convertVipStructToSchema(readStruct); convErr == nil {
-					readModel.Id = data.Id
-					data = readModel
-				}
+			if readModel := readBackCreated(ctx, id, r.client.Interfaces().GetVip, convertVipStructToSchema); readModel != nil {
+				readModel.Id = data.Id
+				data.vipModel = *readModel
 			}
 
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -132,7 +161,7 @@ func (r *vipResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Convert OPNsense struct to TF schema
-	vipModel, err := convertVipStructToSchema(vip)
+	model, err := convertVipStructToSchema(vip)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("Unable to read vip, got error: %s", err))
@@ -140,10 +169,16 @@ func (r *vipResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// ID cannot be added by convert... func, have to add here
-	vipModel.Id = data.Id
+	model.Id = data.Id
+
+	// The password is write-only, keep the version from state
+	resourceModel := &vipResourceModel{
+		vipModel:        *model,
+		PasswordVersion: data.PasswordVersion,
+	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &vipModel)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
 }
 
 func (r *vipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -152,6 +187,9 @@ func (r *vipResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// Write-only password is only present in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &data.Password)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -195,6 +233,43 @@ func (r *vipResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 }
 
+func (r *vipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan *vipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only CARP VIPs with a known VHID and interface need a unique VHID
+	if plan.Mode.ValueString() != "carp" || plan.VHID.IsNull() || plan.VHID.IsUnknown() || plan.Interface.IsUnknown() {
+		return
+	}
+
+	vips, err := r.client.Interfaces().ListVips(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error",
+			fmt.Sprintf("Unable to list vips to validate vhid, got error: %s", err))
+		return
+	}
+
+	vhid := tools.Int64ToString(plan.VHID.ValueInt64())
+	for id, vip := range vips {
+		if id == plan.Id.ValueString() || vip.Mode.String() != "carp" {
+			continue
+		}
+		if vip.Interface.String() == plan.Interface.ValueString() && vip.VHID == vhid {
+			resp.Diagnostics.AddAttributeError(path.Root("vhid"), "Duplicate VHID",
+				fmt.Sprintf("VHID %s is already used by CARP vip %s (%s) on interface %q. Each CARP vip on an interface needs a unique VHID.",
+					vhid, id, vip.Network, vip.Interface.String()))
+		}
+	}
+}
+
 func (r *vipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
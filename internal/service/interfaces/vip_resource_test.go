@@ -2,12 +2,18 @@ package interfaces_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
+// Write-only attributes, such as the CARP password, require Terraform 1.11
+var version1_11_0 = version.Must(version.NewVersion("1.11.0"))
+
 func TestAccInterfacesVipProxyArpResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.AccPreCheck(t) },
@@ -82,6 +88,140 @@ func TestAccInterfacesVipIpAliasResource(t *testing.T) {
 	})
 }
 
+func TestAccInterfacesVipCarpResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(version1_11_0)},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVipCarpResourceConfig(201, 100, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_vip.test", "mode", "carp"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vip.test", "vhid", "201"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vip.test", "advbase", "1"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vip.test", "advskew", "100"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vip.test", "peer", "192.168.2.27"),
+					resource.TestCheckNoResourceAttr("opnsense_interfaces_vip.test", "password"),
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_vip.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_interfaces_vip.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// Update and Read testing, including a password change
+			{
+				Config: testAccVipCarpResourceConfig(202, 0, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_vip.test", "vhid", "202"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vip.test", "advskew", "0"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vip.test", "password_version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInterfacesVipCarpResourceDuplicateVHID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(version1_11_0)},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVipCarpResourceConfig(203, 0, 1),
+			},
+			{
+				Config: testAccVipCarpResourceConfig(203, 0, 1) + `
+resource "opnsense_interfaces_vip" "duplicate" {
+  mode      = "carp"
+  interface = "wan"
+  network   = "192.168.2.28/32"
+  vhid      = opnsense_interfaces_vip.test.vhid
+  password  = "duplicate"
+}
+`,
+				ExpectError: regexp.MustCompile("Duplicate VHID"),
+			},
+		},
+	})
+}
+
+func TestAccInterfacesVipCarpResourceRequiresMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "opnsense_interfaces_vip" "test" {
+  mode      = "ipalias"
+  interface = "wan"
+  network   = "192.168.2.29/32"
+  vhid      = 204
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestAccInterfacesVipCarpResourcePeerFamily(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "opnsense_interfaces_vip" "test" {
+  mode      = "carp"
+  interface = "wan"
+  network   = "192.168.2.30/32"
+  vhid      = 205
+  peer      = "2001:db8::1"
+}
+`,
+				ExpectError: regexp.MustCompile("must be a valid IPv4 address"),
+			},
+			{
+				Config: `
+resource "opnsense_interfaces_vip" "test" {
+  mode      = "carp"
+  interface = "wan"
+  network   = "192.168.2.30/32"
+  vhid      = 205
+  peer6     = "192.168.2.31"
+}
+`,
+				ExpectError: regexp.MustCompile("must be a valid IPv6 address"),
+			},
+		},
+	})
+}
+
+func testAccVipCarpResourceConfig(vhid, advskew, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_vip" "test" {
+  mode             = "carp"
+  description      = "CARP VIP test"
+  interface        = "wan"
+  network          = "192.168.2.26/32"
+  vhid             = %[1]d
+  advskew          = %[2]d
+  password         = "carp-password-%[3]d"
+  password_version = %[3]d
+  peer             = "192.168.2.27"
+}
+`, vhid, advskew, passwordVersion)
+}
+
 func testAccVipResourceConfig(mode, description, interf, network string) string {
 	return fmt.Sprintf(`
 resource "opnsense_interfaces_vip" "test" {
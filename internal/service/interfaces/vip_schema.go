@@ -5,9 +5,11 @@ import (
 	"github.com/browningluke/opnsense-go/pkg/interfaces"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -15,28 +17,41 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// vipResourceModel describes the resource data model.
-type vipResourceModel struct {
+// vipModel describes the data model shared by the resource and data source.
+type vipModel struct {
 	Mode        types.String `tfsdk:"mode"`
 	Interface   types.String `tfsdk:"interface"`
 	Network     types.String `tfsdk:"network"`
 	Gateway     types.String `tfsdk:"gateway"`
+	VHID        types.Int64  `tfsdk:"vhid"`
+	AdvBase     types.Int64  `tfsdk:"advbase"`
+	AdvSkew     types.Int64  `tfsdk:"advskew"`
+	Peer        types.String `tfsdk:"peer"`
+	Peer6       types.String `tfsdk:"peer6"`
 	Description types.String `tfsdk:"description"`
 	Id          types.String `tfsdk:"id"`
 }
 
+// vipResourceModel describes the resource data model. The CARP password is
+// write-only, so it is read from the configuration rather than the plan.
+type vipResourceModel struct {
+	vipModel
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+}
+
 func vipResourceSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Virtual IPs allow an OPNsense firewall to assign multiple IP addresses to the same network interface.",
 
 		Attributes: map[string]schema.Attribute{
 			"mode": schema.StringAttribute{
-				MarkdownDescription: "Mode of the VIP. One of `ipalias` or `proxyarp`. `proxyarp` cannot be bound to by anything running on the firewall, such as IPsec, OpenVPN, etc. In most cases an `ipalias` should be used.",
+				MarkdownDescription: "Mode of the VIP. One of `ipalias`, `carp`, or `proxyarp`. `proxyarp` cannot be bound to by anything running on the firewall, such as IPsec, OpenVPN, etc. `carp` shares the address between the members of a high availability cluster, and requires `vhid` and `password`. In most cases an `ipalias` should be used.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("ipalias"),
 				Validators: []validator.String{
-					stringvalidator.OneOf("ipalias", "proxyarp"),
+					stringvalidator.OneOf("ipalias", "carp", "proxyarp"),
 				},
 			},
 			"interface": schema.StringAttribute{
@@ -59,6 +74,55 @@ func vipResourceSchema() schema.Schema {
 					validators.IpOrCIDR(),
 				},
 			},
+			"vhid": schema.Int64Attribute{
+				MarkdownDescription: "The virtual host ID of the CARP group, shared by all members of the cluster. Must be unique per interface. Only used when `mode` is `carp`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
+			},
+			"advbase": schema.Int64Attribute{
+				MarkdownDescription: "The base interval in seconds at which CARP advertisements are sent. Only used when `mode` is `carp`. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 254),
+				},
+			},
+			"advskew": schema.Int64Attribute{
+				MarkdownDescription: "The skew added to `advbase` for CARP advertisements. The member with the lowest skew becomes master, so use `0` on the primary and e.g. `100` on the backup. Only used when `mode` is `carp`. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 254),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the CARP group, shared by all members of the cluster. Required when `mode` is `carp`. This value is write-only and is not stored in state, so increment `password_version` to apply a new password. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to update the CARP password, as changes to `password` alone are not detected.",
+				Optional:            true,
+			},
+			"peer": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 address of the other cluster member to send CARP advertisements to using unicast, for networks that do not support multicast. Advertisements are sent using multicast if omitted. Only used when `mode` is `carp`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IPv4(),
+				},
+			},
+			"peer6": schema.StringAttribute{
+				MarkdownDescription: "The IPv6 address of the other cluster member to send CARP advertisements to using unicast, for networks that do not support multicast. Advertisements are sent using multicast if omitted. Only used when `mode` is `carp`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IPv6(),
+				},
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Optional:            true,
@@ -99,6 +163,26 @@ func vipDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "For some interface types a gateway is required to configure an IP Alias (ppp/pppoe/tun), leave this field empty for all other interface types.",
 				Computed:            true,
 			},
+			"vhid": dschema.Int64Attribute{
+				MarkdownDescription: "The virtual host ID of the CARP group.",
+				Computed:            true,
+			},
+			"advbase": dschema.Int64Attribute{
+				MarkdownDescription: "The base interval in seconds at which CARP advertisements are sent.",
+				Computed:            true,
+			},
+			"advskew": dschema.Int64Attribute{
+				MarkdownDescription: "The skew added to `advbase` for CARP advertisements.",
+				Computed:            true,
+			},
+			"peer": dschema.StringAttribute{
+				MarkdownDescription: "The IPv4 address CARP advertisements are sent to using unicast, if any.",
+				Computed:            true,
+			},
+			"peer6": dschema.StringAttribute{
+				MarkdownDescription: "The IPv6 address CARP advertisements are sent to using unicast, if any.",
+				Computed:            true,
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Computed:            true,
@@ -108,21 +192,46 @@ func vipDataSourceSchema() dschema.Schema {
 }
 
 func convertVipSchemaToStruct(d *vipResourceModel) (*interfaces.Vip, error) {
+	vhid := ""
+	if !d.VHID.IsNull() {
+		vhid = tools.Int64ToString(d.VHID.ValueInt64())
+	}
+
 	return &interfaces.Vip{
 		Description: d.Description.ValueString(),
 		Mode:        api.SelectedMap(d.Mode.ValueString()),
 		Interface:   api.SelectedMap(d.Interface.ValueString()),
 		Network:     d.Network.ValueString(),
 		Gateway:     d.Gateway.ValueString(),
+		VHID:        vhid,
+		AdvBase:     tools.Int64ToString(d.AdvBase.ValueInt64()),
+		AdvSkew:     tools.Int64ToString(d.AdvSkew.ValueInt64()),
+		Password:    d.Password.ValueString(),
+		Peer:        d.Peer.ValueString(),
+		Peer6:       d.Peer6.ValueString(),
 	}, nil
 }
 
-func convertVipStructToSchema(d *interfaces.Vip) (*vipResourceModel, error) {
-	return &vipResourceModel{
+func convertVipStructToSchema(d *interfaces.Vip) (*vipModel, error) {
+	// CARP timers may be empty for other modes, use the defaults
+	advBase, advSkew := int64(1), int64(0)
+	if d.AdvBase != "" {
+		advBase = tools.StringToInt64(d.AdvBase)
+	}
+	if d.AdvSkew != "" {
+		advSkew = tools.StringToInt64(d.AdvSkew)
+	}
+
+	return &vipModel{
 		Mode:        types.StringValue(d.Mode.String()),
 		Interface:   types.StringValue(d.Interface.String()),
 		Network:     types.StringValue(d.Network),
 		Gateway:     tools.StringOrNull(d.Gateway),
+		VHID:        tools.StringToInt64Null(d.VHID),
+		AdvBase:     types.Int64Value(advBase),
+		AdvSkew:     types.Int64Value(advSkew),
+		Peer:        tools.StringOrNull(d.Peer),
+		Peer6:       tools.StringOrNull(d.Peer6),
 		Description: tools.StringOrNull(d.Description),
 	}, nil
 }
@@ -78,6 +78,14 @@ func (d *vlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// ID cannot be added by convert... func, have to add here
 	resourceModel.Id = data.Id
 
+	assignedInterface, err := vlanAssignedInterface(ctx, d.client, resource.Device)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read interfaces overview, got error: %s", err))
+		return
+	}
+	resourceModel.AssignedInterface = types.StringValue(assignedInterface)
+
 	if data.Device.ValueString() == "" {
 		resourceModel.Device = types.StringValue("")
 	}
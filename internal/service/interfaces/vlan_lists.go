@@ -0,0 +1,52 @@
+package interfaces
+
+import (
+	"context"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/opnsense-go/pkg/opnsense"
+)
+
+// vlanLists are the lists the VLAN resource validates plans with.
+type vlanLists struct {
+	// Devices maps each device name to the identifier of the interface it
+	// is assigned to, or "" if it is not assigned.
+	Devices map[string]string
+	Vlans   map[string]interfaces.Vlan
+}
+
+// fetchVlanLists reads the interfaces overview and the VLANs.
+func fetchVlanLists(ctx context.Context, client opnsense.Client) (*vlanLists, error) {
+	overview, err := client.Interfaces().OverviewGet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vlans, err := client.Interfaces().ListVlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lists := &vlanLists{Devices: map[string]string{}, Vlans: vlans}
+	for _, row := range overview.Rows {
+		lists.Devices[row.Device] = row.Identifier
+	}
+	return lists, nil
+}
+
+// isVlanDevice returns whether device has the name of a VLAN device.
+func isVlanDevice(device string) bool {
+	return strings.HasPrefix(device, "vlan") || strings.HasPrefix(device, "qinq")
+}
+
+// duplicateVlan returns a VLAN other than the one with the given UUID that
+// uses tag on parent.
+func duplicateVlan(vlans map[string]interfaces.Vlan, id, parent, tag string) (string, interfaces.Vlan, bool) {
+	for vlanId, vlan := range vlans {
+		if vlanId != id && vlan.Parent.String() == parent && vlan.Tag == tag {
+			return vlanId, vlan, true
+		}
+	}
+	return "", interfaces.Vlan{}, false
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsVlanDevice(t *testing.T) {
+	require.True(t, isVlanDevice("vlan01"))
+	require.True(t, isVlanDevice("vlan0.10"))
+	require.True(t, isVlanDevice("qinq0300"))
+	require.False(t, isVlanDevice("vtnet0"))
+	require.False(t, isVlanDevice("doesnotexist0"))
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
 	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/browningluke/terraform-provider-opnsense/internal/cache"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +20,7 @@ import (
 var _ resource.Resource = &vlanResource{}
 var _ resource.ResourceWithConfigure = &vlanResource{}
 var _ resource.ResourceWithImportState = &vlanResource{}
+var _ resource.ResourceWithModifyPlan = &vlanResource{}
 
 func newVlanResource() resource.Resource {
 	return &vlanResource{}
@@ -26,6 +29,7 @@ func newVlanResource() resource.Resource {
 // vlanResource defines the resource implementation.
 type vlanResource struct {
 	client opnsense.Client
+	lists  *cache.Cache
 }
 
 func (r *vlanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +56,7 @@ func (r *vlanResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	}
 
 	r.client = opnsense.NewClient(apiClient)
+	r.lists = cache.ForClient(apiClient)
 }
 
 func (r *vlanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -78,15 +83,11 @@ func (r *vlanResource) Create(ctx context.Context, req resource.CreateRequest, r
 		if id != "" {
 			data.Id = types.StringValue(id)
 
-			// Read back so state captures API-normalised values (defaults,
-			// sorting, trimming); fall back to plan-only state if the
-			// read-back fails so the upstream resource isn't orphaned.
-			if readStruct, readErr := r.client.Interfaces().GetVlan(ctx, id); readErr == nil {
-				if readModel, convErr := convertVlanStructToSchema(readStruct); convErr == nil {
-					readModel.Id = data.Id
-					data = readModel
-				}
+			if readModel := readBackCreated(ctx, id, r.client.Interfaces().GetVlan, convertVlanStructToSchema); readModel != nil {
+				readModel.Id = data.Id
+				data = readModel
 			}
+			data.AssignedInterface = types.StringValue("")
 
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		}
@@ -99,6 +100,9 @@ func (r *vlanResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Tag new resource with ID from OPNsense
 	data.Id = types.StringValue(id)
 
+	// A new VLAN device cannot be assigned to an interface yet
+	data.AssignedInterface = types.StringValue("")
+
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a resource")
 
@@ -142,6 +146,16 @@ func (r *vlanResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// ID cannot be added by convert... func, have to add here
 	vlanModel.Id = data.Id
 
+	// Look up the interface the device is assigned to, using the actual
+	// device name in case OPNsense generated it
+	assignedInterface, err := vlanAssignedInterface(ctx, r.client, vlan.Device)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read interfaces overview, got error: %s", err))
+		return
+	}
+	vlanModel.AssignedInterface = types.StringValue(assignedInterface)
+
 	// Handle empty device attribute (i.e. let OPNsense generate a device name)
 	// If the VLAN was created with device == "", then we ignore any changes to device.
 	// During import, data.Device is null (not empty string), so we skip this and
@@ -203,6 +217,98 @@ func (r *vlanResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+func (r *vlanResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan *vlanResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The parent may be the device of a resource that is not created yet
+	if plan.Parent.IsUnknown() {
+		return
+	}
+	parent := plan.Parent.ValueString()
+
+	lists, err := r.vlanLists(ctx, false)
+	if err != nil {
+		addVlanListsWarning(resp, err)
+		return
+	}
+
+	// A VLAN device may be the outer VLAN of QinQ that is created in the same
+	// apply, with a known device name. Other devices must exist, so refetch
+	// before reporting them in case they were created since the lists were
+	// cached.
+	if _, ok := lists.Devices[parent]; !ok && !isVlanDevice(parent) {
+		lists, err = r.vlanLists(ctx, true)
+		if err != nil {
+			addVlanListsWarning(resp, err)
+			return
+		}
+		if _, ok := lists.Devices[parent]; !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("parent"), "Parent Not Found",
+				fmt.Sprintf("Device %q does not exist. Use the name of an existing device, or reference the device attribute of the resource that creates it.", parent))
+		}
+	}
+
+	if plan.Tag.IsUnknown() {
+		return
+	}
+
+	tag := tools.Int64ToString(plan.Tag.ValueInt64())
+	if _, _, ok := duplicateVlan(lists.Vlans, plan.Id.ValueString(), parent, tag); ok {
+		// Refetch before reporting, in case the other VLAN was changed
+		// since the lists were cached
+		lists, err = r.vlanLists(ctx, true)
+		if err != nil {
+			addVlanListsWarning(resp, err)
+			return
+		}
+		if id, vlan, ok := duplicateVlan(lists.Vlans, plan.Id.ValueString(), parent, tag); ok {
+			resp.Diagnostics.AddAttributeError(path.Root("tag"), "Duplicate VLAN Tag",
+				fmt.Sprintf("Tag %s is already used by vlan %s (%s) on parent %q. Each VLAN on a parent needs a unique tag.",
+					tag, id, vlan.Device, parent))
+		}
+	}
+}
+
 func (r *vlanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// vlanLists returns the device and VLAN lists, shared between the VLANs of a
+// plan unless refresh is set.
+func (r *vlanResource) vlanLists(ctx context.Context, refresh bool) (*vlanLists, error) {
+	return cache.Get(r.lists, "interfaces/vlans", refresh, func() (*vlanLists, error) {
+		return fetchVlanLists(ctx, r.client)
+	})
+}
+
+// addVlanListsWarning reports that the plan could not be validated, without
+// failing it.
+func addVlanListsWarning(resp *resource.ModifyPlanResponse, err error) {
+	resp.Diagnostics.AddWarning("Client Error",
+		fmt.Sprintf("Unable to read interfaces overview and vlans to validate parent and tag, got error: %s", err))
+}
+
+// vlanAssignedInterface returns the identifier of the interface device is
+// assigned to, or "" if it is not assigned.
+func vlanAssignedInterface(ctx context.Context, client opnsense.Client, device string) (string, error) {
+	overview, err := client.Interfaces().OverviewGet(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range overview.Rows {
+		if overview.Rows[i].Device == device {
+			return overview.Rows[i].Identifier, nil
+		}
+	}
+	return "", nil
+}
@@ -2,6 +2,7 @@ package interfaces_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
@@ -23,6 +24,8 @@ func TestAccInterfacesVlanResource(t *testing.T) {
 					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.test", "priority", "4"),
 					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.test", "parent", "vtnet0"),
 					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.test", "device", "vlan01"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.test", "proto", "802.1q"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.test", "assigned_interface", ""),
 				),
 			},
 			// ImportState testing
@@ -66,6 +69,72 @@ func TestAccInterfacesVlanResource_HighVlanId(t *testing.T) {
 	})
 }
 
+func TestAccInterfacesVlanResource_QinQ(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "opnsense_interfaces_vlan" "outer" {
+  description = "QinQ service tag"
+  tag         = 300
+  parent      = "vtnet0"
+  proto       = "802.1ad"
+  device      = "qinq0300"
+}
+
+resource "opnsense_interfaces_vlan" "inner" {
+  description = "QinQ customer tag"
+  tag         = 10
+  parent      = opnsense_interfaces_vlan.outer.device
+  device      = "vlan0300.10"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.outer", "proto", "802.1ad"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.inner", "proto", "802.1q"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vlan.inner", "parent", "qinq0300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInterfacesVlanResource_DuplicateTag(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlanResourceConfig(120, "Duplicate tag test", 0, "vtnet0", "vlan0120"),
+			},
+			{
+				Config: testAccVlanResourceConfig(120, "Duplicate tag test", 0, "vtnet0", "vlan0120") + `
+resource "opnsense_interfaces_vlan" "duplicate" {
+  tag    = 120
+  parent = "vtnet0"
+}
+`,
+				ExpectError: regexp.MustCompile("Duplicate VLAN Tag"),
+			},
+		},
+	})
+}
+
+func TestAccInterfacesVlanResource_ParentNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVlanResourceConfig(130, "Parent not found test", 0, "doesnotexist0", ""),
+				ExpectError: regexp.MustCompile("Parent Not Found"),
+			},
+		},
+	})
+}
+
 func testAccVlanResourceConfig(tag int, description string, priority int, parent string, device string) string {
 	return fmt.Sprintf(`
 resource "opnsense_interfaces_vlan" "test" {
@@ -5,6 +5,7 @@ import (
 	"github.com/browningluke/opnsense-go/pkg/interfaces"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -21,8 +22,11 @@ type vlanResourceModel struct {
 	Tag         types.Int64  `tfsdk:"tag"`
 	Priority    types.Int64  `tfsdk:"priority"`
 	Parent      types.String `tfsdk:"parent"`
+	Proto       types.String `tfsdk:"proto"`
 	Device      types.String `tfsdk:"device"`
 
+	AssignedInterface types.String `tfsdk:"assigned_interface"`
+
 	Id types.String `tfsdk:"id"`
 }
 
@@ -52,9 +56,18 @@ func vlanResourceSchema() schema.Schema {
 				},
 			},
 			"parent": schema.StringAttribute{
-				MarkdownDescription: "VLAN capable interface to attach the VLAN to, e.g. `vtnet0`.",
+				MarkdownDescription: "VLAN capable interface to attach the VLAN to, e.g. `vtnet0`. Must be an existing device, a VLAN device created in the same apply (e.g. the outer VLAN of QinQ), or a reference to the `device` of another resource. Each tag can only be used once per parent.",
 				Required:            true,
 			},
+			"proto": schema.StringAttribute{
+				MarkdownDescription: "VLAN protocol. One of `802.1q` or `802.1ad`. Use `802.1ad` for the outer (service) tag of QinQ, and attach the inner VLAN to the `device` of the outer VLAN. Defaults to `802.1q`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("802.1q"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("802.1q", "802.1ad"),
+				},
+			},
 			"device": schema.StringAttribute{
 				MarkdownDescription: "Custom VLAN name. Custom names are possible, but only if the start of the name matches the required prefix and contains numeric characters or dots, e.g. `vlan0.1.2` or `qinq0.3.4`. Set to `\"\"` to generate a device name. Defaults to `\"\"`",
 				Optional:            true,
@@ -64,6 +77,13 @@ func vlanResourceSchema() schema.Schema {
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"assigned_interface": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the interface the VLAN device is assigned to (e.g. `opt1`), or `\"\"` if it is not assigned.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "UUID of the VLAN.",
@@ -100,10 +120,18 @@ func vlanDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "VLAN capable interface to attach the VLAN to, e.g. `vtnet0`.",
 				Computed:            true,
 			},
+			"proto": dschema.StringAttribute{
+				MarkdownDescription: "VLAN protocol, either `802.1q` or `802.1ad` (QinQ).",
+				Computed:            true,
+			},
 			"device": dschema.StringAttribute{
 				MarkdownDescription: "Custom VLAN name. Custom names are possible, but only if the start of the name matches the required prefix and contains numeric characters or dots, e.g. `vlan0.1.2` or `qinq0.3.4`.",
 				Computed:            true,
 			},
+			"assigned_interface": dschema.StringAttribute{
+				MarkdownDescription: "Identifier of the interface the VLAN device is assigned to (e.g. `opt1`), or `\"\"` if it is not assigned.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -114,6 +142,7 @@ func convertVlanSchemaToStruct(d *vlanResourceModel) (*interfaces.Vlan, error) {
 		Tag:         tools.Int64ToString(d.Tag.ValueInt64()),
 		Priority:    api.SelectedMap(tools.Int64ToString(d.Priority.ValueInt64())),
 		Parent:      api.SelectedMap(d.Parent.ValueString()),
+		Proto:       api.SelectedMap(vlanProtoToStruct(d.Proto.ValueString())),
 		Device:      d.Device.ValueString(),
 	}, nil
 }
@@ -132,6 +161,22 @@ func convertVlanStructToSchema(d *interfaces.Vlan) (*vlanResourceModel, error) {
 		Tag:         tools.StringToInt64Null(d.Tag),
 		Priority:    types.Int64Value(priority),
 		Parent:      types.StringValue(d.Parent.String()),
+		Proto:       types.StringValue(vlanProtoToSchema(d.Proto.String())),
 		Device:      types.StringValue(d.Device),
 	}, nil
 }
+
+// OPNsense stores 802.1Q, the default protocol, as an empty value.
+func vlanProtoToStruct(proto string) string {
+	if proto == "802.1q" {
+		return ""
+	}
+	return proto
+}
+
+func vlanProtoToSchema(proto string) string {
+	if proto == "" {
+		return "802.1q"
+	}
+	return proto
+}
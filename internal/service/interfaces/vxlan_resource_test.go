@@ -0,0 +1,74 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesVxlanResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVxlanResourceConfig("VXLAN test", 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("opnsense_interfaces_vxlan.test", "id"),
+					resource.TestMatchResourceAttr("opnsense_interfaces_vxlan.test", "device", regexp.MustCompile(`^vxlan[0-9]+$`)),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "description", "VXLAN test"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "vni", "100"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "local_port", "-1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_vxlan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVxlanResourceConfig("Updated VXLAN", 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "description", "Updated VXLAN"),
+					resource.TestCheckResourceAttr("opnsense_interfaces_vxlan.test", "vni", "200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInterfacesVxlanResourceRequiresDestination(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "opnsense_interfaces_vxlan" "test" {
+  vni           = 100
+  local_address = "192.168.1.1"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccVxlanResourceConfig(description string, vni int) string {
+	return fmt.Sprintf(`
+resource "opnsense_interfaces_vxlan" "test" {
+  description    = %[1]q
+  vni            = %[2]d
+  local_address  = "192.168.1.1"
+  remote_address = "192.168.1.2"
+}
+`, description, vni)
+}
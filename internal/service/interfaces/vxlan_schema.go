@@ -0,0 +1,197 @@
+package interfaces
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vxlanResourceModel describes the resource data model.
+type vxlanResourceModel struct {
+	Description     types.String `tfsdk:"description"`
+	VNI             types.Int64  `tfsdk:"vni"`
+	LocalAddress    types.String `tfsdk:"local_address"`
+	LocalPort       types.Int64  `tfsdk:"local_port"`
+	RemoteAddress   types.String `tfsdk:"remote_address"`
+	RemotePort      types.Int64  `tfsdk:"remote_port"`
+	Group           types.String `tfsdk:"group"`
+	MulticastDevice types.String `tfsdk:"multicast_device"`
+	Device          types.String `tfsdk:"device"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func vxlanResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "VXLAN (Virtual eXtensible LAN) interfaces carry layer 2 networks over UDP, either point-to-point to a remote address or to a multicast group.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"vni": schema.Int64Attribute{
+				MarkdownDescription: "The VXLAN network identifier, shared by all endpoints of the network.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 16777215),
+				},
+			},
+			"local_address": schema.StringAttribute{
+				MarkdownDescription: "The local address VXLAN packets are sent from.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"local_port": schema.Int64Attribute{
+				MarkdownDescription: "The local UDP port. Set to `-1` to use the default port (4789). Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"remote_address": schema.StringAttribute{
+				MarkdownDescription: "The address of the remote endpoint, for point-to-point networks. Conflicts with `group`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IP(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group")),
+				},
+			},
+			"remote_port": schema.Int64Attribute{
+				MarkdownDescription: "The remote UDP port. Set to `-1` to use the default port (4789). Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The multicast group address to send packets to, for networks with multiple endpoints. Requires `multicast_device`. Conflicts with `remote_address`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IP(),
+					stringvalidator.AlsoRequires(path.MatchRoot("multicast_device")),
+				},
+			},
+			"multicast_device": schema.StringAttribute{
+				MarkdownDescription: "The device used to send multicast packets to `group`, e.g. `vtnet1`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("group")),
+				},
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "The device name generated by OPNsense, e.g. `vxlan0`. Use this to assign the VXLAN with `opnsense_interfaces_assignment`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the VXLAN.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func vxlanDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "VXLAN (Virtual eXtensible LAN) interfaces carry layer 2 networks over UDP, either point-to-point to a remote address or to a multicast group.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"vni": dschema.Int64Attribute{
+				MarkdownDescription: "The VXLAN network identifier.",
+				Computed:            true,
+			},
+			"local_address": dschema.StringAttribute{
+				MarkdownDescription: "The local address VXLAN packets are sent from.",
+				Computed:            true,
+			},
+			"local_port": dschema.Int64Attribute{
+				MarkdownDescription: "The local UDP port, `-1` for the default port.",
+				Computed:            true,
+			},
+			"remote_address": dschema.StringAttribute{
+				MarkdownDescription: "The address of the remote endpoint, for point-to-point networks.",
+				Computed:            true,
+			},
+			"remote_port": dschema.Int64Attribute{
+				MarkdownDescription: "The remote UDP port, `-1` for the default port.",
+				Computed:            true,
+			},
+			"group": dschema.StringAttribute{
+				MarkdownDescription: "The multicast group address packets are sent to.",
+				Computed:            true,
+			},
+			"multicast_device": dschema.StringAttribute{
+				MarkdownDescription: "The device used to send multicast packets.",
+				Computed:            true,
+			},
+			"device": dschema.StringAttribute{
+				MarkdownDescription: "The device name of the VXLAN, e.g. `vxlan0`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertVxlanSchemaToStruct(d *vxlanResourceModel) (*interfaces.Vxlan, error) {
+	return &interfaces.Vxlan{
+		Description:     d.Description.ValueString(),
+		VNI:             tools.Int64ToString(d.VNI.ValueInt64()),
+		LocalAddress:    d.LocalAddress.ValueString(),
+		LocalPort:       tools.Int64ToStringNegative(d.LocalPort.ValueInt64()),
+		RemoteAddress:   d.RemoteAddress.ValueString(),
+		RemotePort:      tools.Int64ToStringNegative(d.RemotePort.ValueInt64()),
+		Group:           d.Group.ValueString(),
+		MulticastDevice: api.SelectedMap(d.MulticastDevice.ValueString()),
+	}, nil
+}
+
+func convertVxlanStructToSchema(d *interfaces.Vxlan) (*vxlanResourceModel, error) {
+	return &vxlanResourceModel{
+		Description:     tools.StringOrNull(d.Description),
+		VNI:             tools.StringToInt64Null(d.VNI),
+		LocalAddress:    types.StringValue(d.LocalAddress),
+		LocalPort:       types.Int64Value(tools.StringToInt64(d.LocalPort)),
+		RemoteAddress:   tools.StringOrNull(d.RemoteAddress),
+		RemotePort:      types.Int64Value(tools.StringToInt64(d.RemotePort)),
+		Group:           tools.StringOrNull(d.Group),
+		MulticastDevice: tools.StringOrNull(d.MulticastDevice.String()),
+		Device:          types.StringValue(d.Device),
+	}, nil
+}
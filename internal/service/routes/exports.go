@@ -10,11 +10,16 @@ import (
 func Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		newRouteResource,
+		newGatewayResource,
+		newGatewayGroupResource,
 	}
 }
 
 func DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newRouteDataSource,
+		newGatewayDataSource,
+		newGatewayGroupDataSource,
+		newGatewayStatusDataSource,
 	}
 }
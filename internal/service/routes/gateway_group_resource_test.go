@@ -0,0 +1,77 @@
+package routes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutingGatewayGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingGatewayGroupResourceConfig("down", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_routing_gateway_group.test", "name", "TF_TEST_FAILOVER"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway_group.test", "trigger", "down"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway_group.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("opnsense_routing_gateway_group.test", "members.*", map[string]string{
+						"gateway": testRouteGateway,
+						"tier":    "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("opnsense_routing_gateway_group.test", "members.*", map[string]string{
+						"gateway": "TF_TEST_GROUP_GW",
+						"tier":    "2",
+					}),
+					resource.TestCheckResourceAttrSet("opnsense_routing_gateway_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "opnsense_routing_gateway_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingGatewayGroupResourceConfig("packet_loss_or_high_latency", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_routing_gateway_group.test", "trigger", "packet_loss_or_high_latency"),
+					resource.TestCheckTypeSetElemNestedAttrs("opnsense_routing_gateway_group.test", "members.*", map[string]string{
+						"gateway": "TF_TEST_GROUP_GW",
+						"tier":    "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutingGatewayGroupResourceConfig(trigger string, tier int) string {
+	return fmt.Sprintf(`
+resource "opnsense_routing_gateway" "test" {
+  name        = "TF_TEST_GROUP_GW"
+  interface   = "wan"
+  address     = "192.0.2.1"
+  far_gateway = true
+}
+
+resource "opnsense_routing_gateway_group" "test" {
+  name    = "TF_TEST_FAILOVER"
+  trigger = %[2]q
+
+  members = [
+    {
+      gateway = %[3]q
+      tier    = 1
+    },
+    {
+      gateway = opnsense_routing_gateway.test.name
+      tier    = %[1]d
+    },
+  ]
+}
+`, tier, trigger, testRouteGateway)
+}
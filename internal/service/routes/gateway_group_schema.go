@@ -0,0 +1,209 @@
+package routes
+
+import (
+	"context"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/routing"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gatewayGroupResourceModel describes the resource data model.
+type gatewayGroupResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Members     types.Set    `tfsdk:"members"`
+	Trigger     types.String `tfsdk:"trigger"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+type gatewayGroupMemberModel struct {
+	Gateway types.String `tfsdk:"gateway"`
+	Tier    types.Int64  `tfsdk:"tier"`
+}
+
+var gatewayGroupMemberAttrTypes = map[string]attr.Type{
+	"gateway": types.StringType,
+	"tier":    types.Int64Type,
+}
+
+func gatewayGroupResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Gateway groups combine gateways in tiers for failover and load balancing. Use the name of the group as the gateway of a firewall rule for multi-WAN policy routing.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the gateway group, e.g. `WAN_FAILOVER`. This is the name used by firewall rules to reference the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "The gateways of the group. Traffic is sent to the lowest tier with a gateway that is up, and load balanced between the gateways of the same tier.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "Name of the gateway, e.g. `opnsense_routing_gateway.example.name`.",
+							Required:            true,
+						},
+						"tier": schema.Int64Attribute{
+							MarkdownDescription: "Tier of the gateway, from `1` (highest priority) to `5`.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 5),
+							},
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"trigger": schema.StringAttribute{
+				MarkdownDescription: "When to consider a gateway down. One of `down` (member down), `packet_loss`, `high_latency`, or `packet_loss_or_high_latency`. Defaults to `down`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("down"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("down", "packet_loss", "high_latency", "packet_loss_or_high_latency"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the gateway group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func gatewayGroupDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Gateway groups combine gateways in tiers for failover and load balancing. Use the name of the group as the gateway of a firewall rule for multi-WAN policy routing.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the gateway group.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"members": dschema.SetNestedAttribute{
+				MarkdownDescription: "The gateways of the group.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"gateway": dschema.StringAttribute{
+							MarkdownDescription: "Name of the gateway.",
+							Computed:            true,
+						},
+						"tier": dschema.Int64Attribute{
+							MarkdownDescription: "Tier of the gateway.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"trigger": dschema.StringAttribute{
+				MarkdownDescription: "When a gateway is considered down.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// gatewayGroupTriggers maps the trigger of the schema to the trigger of the
+// API.
+var gatewayGroupTriggers = map[string]string{
+	"down":                        "down",
+	"packet_loss":                 "downloss",
+	"high_latency":                "downlatency",
+	"packet_loss_or_high_latency": "downlosslatency",
+}
+
+func convertGatewayGroupSchemaToStruct(d *gatewayGroupResourceModel) (*routing.GatewayGroup, error) {
+	var membersList []gatewayGroupMemberModel
+	d.Members.ElementsAs(context.Background(), &membersList, false)
+
+	members := []routing.GatewayGroupMember{}
+	for _, m := range membersList {
+		members = append(members, routing.GatewayGroupMember{
+			Gateway: m.Gateway.ValueString(),
+			Tier:    tools.Int64ToString(m.Tier.ValueInt64()),
+		})
+	}
+
+	return &routing.GatewayGroup{
+		Name:        d.Name.ValueString(),
+		Description: d.Description.ValueString(),
+		Members:     members,
+		Trigger:     api.SelectedMap(gatewayGroupTriggers[d.Trigger.ValueString()]),
+	}, nil
+}
+
+func convertGatewayGroupStructToSchema(d *routing.GatewayGroup) (*gatewayGroupResourceModel, error) {
+	trigger := "down"
+	for k, v := range gatewayGroupTriggers {
+		if v == d.Trigger.String() {
+			trigger = k
+		}
+	}
+
+	members := []gatewayGroupMemberModel{}
+	for _, m := range d.Members {
+		members = append(members, gatewayGroupMemberModel{
+			Gateway: types.StringValue(m.Gateway),
+			Tier:    types.Int64Value(tools.StringToInt64(m.Tier)),
+		})
+	}
+
+	// Create empty set first
+	v, _ := types.SetValue(
+		types.ObjectType{AttrTypes: gatewayGroupMemberAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill set
+	if len(members) > 0 {
+		v, _ = types.SetValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: gatewayGroupMemberAttrTypes},
+			members,
+		)
+	}
+
+	return &gatewayGroupResourceModel{
+		Name:        types.StringValue(d.Name),
+		Description: tools.StringOrNull(d.Description),
+		Members:     v,
+		Trigger:     types.StringValue(trigger),
+	}, nil
+}
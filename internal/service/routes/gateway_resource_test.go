@@ -0,0 +1,105 @@
+package routes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutingGatewayResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingGatewayResourceConfig("TF_TEST_GW", "192.0.2.1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "name", "TF_TEST_GW"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "interface", "wan"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "ip_protocol", "inet"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "address", "192.0.2.1"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "far_gateway", "true"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "weight", "1"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "priority", "255"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "latency_low", "200"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "latency_high", "500"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "loss_low", "10"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "loss_high", "20"),
+					resource.TestCheckResourceAttrSet("opnsense_routing_gateway.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "opnsense_routing_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingGatewayResourceConfig("TF_TEST_GW", "192.0.2.2", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "address", "192.0.2.2"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "weight", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoutingGatewayResource_Thresholds(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingGatewayResourceConfigThresholds(100, 300, 5, 15),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "monitor_ip", "198.51.100.53"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "latency_low", "100"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "latency_high", "300"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "loss_low", "5"),
+					resource.TestCheckResourceAttr("opnsense_routing_gateway.test", "loss_high", "15"),
+				),
+			},
+			{
+				ResourceName:      "opnsense_routing_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccRoutingGatewayResourceConfigThresholds(500, 200, 5, 15),
+				ExpectError: regexp.MustCompile("latency_high"),
+			},
+		},
+	})
+}
+
+func testAccRoutingGatewayResourceConfig(name, address string, weight int) string {
+	return fmt.Sprintf(`
+resource "opnsense_routing_gateway" "test" {
+  name        = %[1]q
+  interface   = "wan"
+  address     = %[2]q
+  far_gateway = true
+  weight      = %[3]d
+}
+`, name, address, weight)
+}
+
+func testAccRoutingGatewayResourceConfigThresholds(latencyLow, latencyHigh, lossLow, lossHigh int) string {
+	return fmt.Sprintf(`
+resource "opnsense_routing_gateway" "test" {
+  name         = "TF_TEST_GW_THRESHOLDS"
+  interface    = "wan"
+  address      = "192.0.2.1"
+  far_gateway  = true
+  monitor_ip   = "198.51.100.53"
+  latency_low  = %[1]d
+  latency_high = %[2]d
+  loss_low     = %[3]d
+  loss_high    = %[4]d
+}
+`, latencyLow, latencyHigh, lossLow, lossHigh)
+}
@@ -0,0 +1,307 @@
+package routes
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/routing"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gatewayResourceModel describes the resource data model.
+type gatewayResourceModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Interface       types.String `tfsdk:"interface"`
+	IPProtocol      types.String `tfsdk:"ip_protocol"`
+	Address         types.String `tfsdk:"address"`
+	DefaultGateway  types.Bool   `tfsdk:"default_gateway"`
+	FarGateway      types.Bool   `tfsdk:"far_gateway"`
+	MonitorDisabled types.Bool   `tfsdk:"monitor_disabled"`
+	MonitorIP       types.String `tfsdk:"monitor_ip"`
+	Weight          types.Int64  `tfsdk:"weight"`
+	Priority        types.Int64  `tfsdk:"priority"`
+	LatencyLow      types.Int64  `tfsdk:"latency_low"`
+	LatencyHigh     types.Int64  `tfsdk:"latency_high"`
+	LossLow         types.Int64  `tfsdk:"loss_low"`
+	LossHigh        types.Int64  `tfsdk:"loss_high"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func gatewayResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Gateways are the next hops for routes and policy based routing. Their health is monitored, so traffic can fail over to another gateway using `opnsense_routing_gateway_group`.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this gateway. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the gateway, e.g. `WAN2_GW`. This is the name used by routes, firewall rules and gateway groups to reference the gateway.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface the gateway is reachable on, e.g. `wan` or `opt1`.",
+				Required:            true,
+			},
+			"ip_protocol": schema.StringAttribute{
+				MarkdownDescription: "The address family of the gateway. One of `inet` or `inet6`. Defaults to `inet`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("inet"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("inet", "inet6"),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The address of the gateway, or `dynamic` to use the address learned by DHCP or PPP on `interface`. Defaults to `dynamic`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("dynamic"),
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf("dynamic"),
+						validators.IP(),
+					),
+				},
+			},
+			"default_gateway": schema.BoolAttribute{
+				MarkdownDescription: "Use this gateway as the default gateway of its address family. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"far_gateway": schema.BoolAttribute{
+				MarkdownDescription: "Allow the gateway to be outside of the subnet of `interface`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"monitor_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Disable monitoring of this gateway, so it is always considered up. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"monitor_ip": schema.StringAttribute{
+				MarkdownDescription: "Address to monitor the gateway with, e.g. a public DNS server. The gateway address is monitored if omitted.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IP(),
+				},
+			},
+			"weight": schema.Int64Attribute{
+				MarkdownDescription: "Weight of the gateway when load balancing in a gateway group. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the gateway, the gateway with the lowest priority becomes the default gateway when using gateway switching. Defaults to `255`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(255),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"latency_low": schema.Int64Attribute{
+				MarkdownDescription: "Latency in milliseconds above which the gateway is considered degraded. Defaults to `200`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(200),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"latency_high": schema.Int64Attribute{
+				MarkdownDescription: "Latency in milliseconds above which the gateway is considered down. Must be greater than or equal to `latency_low`. Defaults to `500`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(500),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"loss_low": schema.Int64Attribute{
+				MarkdownDescription: "Packet loss in percent above which the gateway is considered degraded. Defaults to `10`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"loss_high": schema.Int64Attribute{
+				MarkdownDescription: "Packet loss in percent above which the gateway is considered down. Must be greater than or equal to `loss_low`. Defaults to `20`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(20),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the gateway.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func gatewayDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Gateways are the next hops for routes and policy based routing. Their health is monitored, so traffic can fail over to another gateway using `opnsense_routing_gateway_group`.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this gateway is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the gateway.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface the gateway is reachable on.",
+				Computed:            true,
+			},
+			"ip_protocol": dschema.StringAttribute{
+				MarkdownDescription: "The address family of the gateway, either `inet` or `inet6`.",
+				Computed:            true,
+			},
+			"address": dschema.StringAttribute{
+				MarkdownDescription: "The address of the gateway, or `dynamic`.",
+				Computed:            true,
+			},
+			"default_gateway": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this gateway is the default gateway of its address family.",
+				Computed:            true,
+			},
+			"far_gateway": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the gateway may be outside of the subnet of its interface.",
+				Computed:            true,
+			},
+			"monitor_disabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether monitoring of this gateway is disabled.",
+				Computed:            true,
+			},
+			"monitor_ip": dschema.StringAttribute{
+				MarkdownDescription: "Address the gateway is monitored with.",
+				Computed:            true,
+			},
+			"weight": dschema.Int64Attribute{
+				MarkdownDescription: "Weight of the gateway when load balancing.",
+				Computed:            true,
+			},
+			"priority": dschema.Int64Attribute{
+				MarkdownDescription: "Priority of the gateway.",
+				Computed:            true,
+			},
+			"latency_low": dschema.Int64Attribute{
+				MarkdownDescription: "Latency in milliseconds above which the gateway is considered degraded.",
+				Computed:            true,
+			},
+			"latency_high": dschema.Int64Attribute{
+				MarkdownDescription: "Latency in milliseconds above which the gateway is considered down.",
+				Computed:            true,
+			},
+			"loss_low": dschema.Int64Attribute{
+				MarkdownDescription: "Packet loss in percent above which the gateway is considered degraded.",
+				Computed:            true,
+			},
+			"loss_high": dschema.Int64Attribute{
+				MarkdownDescription: "Packet loss in percent above which the gateway is considered down.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertGatewaySchemaToStruct(d *gatewayResourceModel) (*routing.Gateway, error) {
+	return &routing.Gateway{
+		Disabled:        tools.BoolToString(!d.Enabled.ValueBool()),
+		Name:            d.Name.ValueString(),
+		Description:     d.Description.ValueString(),
+		Interface:       api.SelectedMap(d.Interface.ValueString()),
+		IPProtocol:      api.SelectedMap(d.IPProtocol.ValueString()),
+		Gateway:         d.Address.ValueString(),
+		DefaultGateway:  tools.BoolToString(d.DefaultGateway.ValueBool()),
+		FarGateway:      tools.BoolToString(d.FarGateway.ValueBool()),
+		MonitorDisabled: tools.BoolToString(d.MonitorDisabled.ValueBool()),
+		Monitor:         d.MonitorIP.ValueString(),
+		Weight:          tools.Int64ToString(d.Weight.ValueInt64()),
+		Priority:        tools.Int64ToString(d.Priority.ValueInt64()),
+		LatencyLow:      tools.Int64ToString(d.LatencyLow.ValueInt64()),
+		LatencyHigh:     tools.Int64ToString(d.LatencyHigh.ValueInt64()),
+		LossLow:         tools.Int64ToString(d.LossLow.ValueInt64()),
+		LossHigh:        tools.Int64ToString(d.LossHigh.ValueInt64()),
+	}, nil
+}
+
+func convertGatewayStructToSchema(d *routing.Gateway) (*gatewayResourceModel, error) {
+	// address is Optional+Computed with a default, so fall back to the schema
+	// default when the API responds with an empty string.
+	address := "dynamic"
+	if d.Gateway != "" {
+		address = d.Gateway
+	}
+
+	return &gatewayResourceModel{
+		Enabled:         types.BoolValue(!tools.StringToBool(d.Disabled)),
+		Name:            types.StringValue(d.Name),
+		Description:     tools.StringOrNull(d.Description),
+		Interface:       types.StringValue(d.Interface.String()),
+		IPProtocol:      types.StringValue(d.IPProtocol.String()),
+		Address:         types.StringValue(address),
+		DefaultGateway:  types.BoolValue(tools.StringToBool(d.DefaultGateway)),
+		FarGateway:      types.BoolValue(tools.StringToBool(d.FarGateway)),
+		MonitorDisabled: types.BoolValue(tools.StringToBool(d.MonitorDisabled)),
+		MonitorIP:       tools.StringOrNull(d.Monitor),
+		Weight:          types.Int64Value(tools.StringToInt64(d.Weight)),
+		Priority:        types.Int64Value(tools.StringToInt64(d.Priority)),
+		LatencyLow:      types.Int64Value(tools.StringToInt64(d.LatencyLow)),
+		LatencyHigh:     types.Int64Value(tools.StringToInt64(d.LatencyHigh)),
+		LossLow:         types.Int64Value(tools.StringToInt64(d.LossLow)),
+		LossHigh:        types.Int64Value(tools.StringToInt64(d.LossHigh)),
+	}, nil
+}
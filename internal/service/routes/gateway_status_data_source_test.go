@@ -0,0 +1,44 @@
+package routes_test
+
+import (
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutingGatewayStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingGatewayStatusDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.opnsense_routing_gateway_status.test", "gateways.*", map[string]string{
+						"name":    "TF_TEST_STATUS_GW",
+						"monitor": "192.0.2.1",
+					}),
+					resource.TestCheckResourceAttrSet("data.opnsense_routing_gateway_status.test", "gateways.0.status"),
+					resource.TestCheckResourceAttrSet("data.opnsense_routing_gateway_status.test", "gateways.0.rtt"),
+					resource.TestCheckResourceAttrSet("data.opnsense_routing_gateway_status.test", "gateways.0.loss"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutingGatewayStatusDataSourceConfig() string {
+	return `
+resource "opnsense_routing_gateway" "test" {
+  name        = "TF_TEST_STATUS_GW"
+  interface   = "wan"
+  address     = "192.0.2.1"
+  far_gateway = true
+}
+
+data "opnsense_routing_gateway_status" "test" {
+  depends_on = [opnsense_routing_gateway.test]
+}
+`
+}
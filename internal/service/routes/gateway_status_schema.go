@@ -0,0 +1,147 @@
+package routes
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/routing"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type gatewayStatusDataSourceModel struct {
+	Gateways types.List `tfsdk:"gateways"`
+}
+
+type gatewayStatusGatewayModel struct {
+	Name              types.String  `tfsdk:"name"`
+	Address           types.String  `tfsdk:"address"`
+	Monitor           types.String  `tfsdk:"monitor"`
+	Status            types.String  `tfsdk:"status"`
+	StatusDescription types.String  `tfsdk:"status_description"`
+	Rtt               types.Float64 `tfsdk:"rtt"`
+	Rttd              types.Float64 `tfsdk:"rttd"`
+	Loss              types.Float64 `tfsdk:"loss"`
+}
+
+var gatewayStatusGatewayAttrTypes = map[string]attr.Type{
+	"name":               types.StringType,
+	"address":            types.StringType,
+	"monitor":            types.StringType,
+	"status":             types.StringType,
+	"status_description": types.StringType,
+	"rtt":                types.Float64Type,
+	"rttd":               types.Float64Type,
+	"loss":               types.Float64Type,
+}
+
+func gatewayStatusDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Reads the live status of all gateways (see `opnsense_routing_gateway`) as measured by the gateway monitor, e.g. to detect a failed uplink after changing routes or firewall rules.",
+
+		Attributes: map[string]schema.Attribute{
+			"gateways": schema.ListNestedAttribute{
+				MarkdownDescription: "The gateways, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the gateway.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The current address of the gateway.",
+							Computed:            true,
+						},
+						"monitor": schema.StringAttribute{
+							MarkdownDescription: "The address the gateway is monitored with.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the gateway. One of `online`, `down`, `loss` (packet loss above `loss_low`) or `delay` (latency above `latency_low`). Gateways with monitoring disabled are always `online`.",
+							Computed:            true,
+						},
+						"status_description": schema.StringAttribute{
+							MarkdownDescription: "The status of the gateway as shown in the web interface, e.g. `Online`.",
+							Computed:            true,
+						},
+						"rtt": schema.Float64Attribute{
+							MarkdownDescription: "The round trip time in milliseconds, `-1` if unknown.",
+							Computed:            true,
+						},
+						"rttd": schema.Float64Attribute{
+							MarkdownDescription: "The standard deviation of the round trip time in milliseconds, `-1` if unknown.",
+							Computed:            true,
+						},
+						"loss": schema.Float64Attribute{
+							MarkdownDescription: "The packet loss in percent, `-1` if unknown.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// gatewayStatuses maps the status of the API to the status of the schema.
+var gatewayStatuses = map[string]string{
+	"none":       "online",
+	"down":       "down",
+	"force_down": "down",
+	"loss":       "loss",
+	"delay":      "delay",
+	"delay+loss": "loss",
+}
+
+// gatewayStatusValue parses a measurement of the API, e.g. `0.4 ms` or
+// `0.0 %`. Measurements that are not available (`~`) are returned as -1.
+func gatewayStatusValue(s string) float64 {
+	s = strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(s), "ms"), "%")
+	return tools.StringToFloat64(strings.TrimSpace(s))
+}
+
+func convertGatewayStatusStructToSchema(d []routing.GatewayStatus) *gatewayStatusDataSourceModel {
+	var gateways []gatewayStatusGatewayModel
+	for _, g := range d {
+		status, ok := gatewayStatuses[g.Status]
+		if !ok {
+			status = g.Status
+		}
+
+		gateways = append(gateways, gatewayStatusGatewayModel{
+			Name:              types.StringValue(g.Name),
+			Address:           types.StringValue(g.Address),
+			Monitor:           types.StringValue(g.Monitor),
+			Status:            types.StringValue(status),
+			StatusDescription: types.StringValue(g.StatusTranslated),
+			Rtt:               types.Float64Value(gatewayStatusValue(g.Delay)),
+			Rttd:              types.Float64Value(gatewayStatusValue(g.Stddev)),
+			Loss:              types.Float64Value(gatewayStatusValue(g.Loss)),
+		})
+	}
+	sort.Slice(gateways, func(i, j int) bool {
+		return gateways[i].Name.ValueString() < gateways[j].Name.ValueString()
+	})
+
+	// Create empty list first
+	v, _ := types.ListValue(
+		types.ObjectType{AttrTypes: gatewayStatusGatewayAttrTypes},
+		[]attr.Value{},
+	)
+	// Try to fill list
+	if len(gateways) > 0 {
+		v, _ = types.ListValueFrom(
+			context.Background(),
+			types.ObjectType{AttrTypes: gatewayStatusGatewayAttrTypes},
+			gateways,
+		)
+	}
+
+	return &gatewayStatusDataSourceModel{
+		Gateways: v,
+	}
+}
@@ -18,6 +18,7 @@ import (
 var _ resource.Resource = &routeResource{}
 var _ resource.ResourceWithConfigure = &routeResource{}
 var _ resource.ResourceWithImportState = &routeResource{}
+var _ resource.ResourceWithModifyPlan = &routeResource{}
 
 func newRouteResource() resource.Resource {
 	return &routeResource{}
@@ -195,6 +196,83 @@ func (r *routeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *routeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan *routeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Network.IsUnknown() {
+		return
+	}
+	network, err := routeNetworkPrefix(plan.Network.ValueString())
+	if err != nil {
+		// Invalid networks are reported by the schema validators
+		return
+	}
+
+	// The gateway must be of the same address family as the network
+	if !plan.Gateway.IsUnknown() {
+		gateways, err := r.client.Routing().ListGateways(ctx)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Client Error",
+				fmt.Sprintf("Unable to list gateways to validate gateway, got error: %s", err))
+		}
+
+		found := false
+		for _, g := range gateways {
+			if g.Name != plan.Gateway.ValueString() {
+				continue
+			}
+			found = true
+
+			ipProtocol := "inet"
+			if network.Addr().Is6() {
+				ipProtocol = "inet6"
+			}
+			if g.IPProtocol.String() != ipProtocol {
+				resp.Diagnostics.AddAttributeError(path.Root("gateway"), "Address Family Mismatch",
+					fmt.Sprintf("Gateway %q is an %s gateway, but network %s is %s. The gateway of a route must be of the same address family as its network.",
+						g.Name, g.IPProtocol.String(), network, ipProtocol))
+			}
+		}
+		if err == nil && !found {
+			resp.Diagnostics.AddAttributeWarning(path.Root("gateway"), "Gateway Not Found",
+				fmt.Sprintf("Gateway %q does not exist. This is expected if it is created in the same apply, e.g. by opnsense_routing_gateway.",
+					plan.Gateway.ValueString()))
+		}
+	}
+
+	// Each destination can only be routed once
+	routes, err := r.client.Routes().ListRoutes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error",
+			fmt.Sprintf("Unable to list routes to validate network, got error: %s", err))
+		return
+	}
+
+	for id, route := range routes {
+		if id == plan.Id.ValueString() {
+			continue
+		}
+		other, err := routeNetworkPrefix(route.Network)
+		if err != nil {
+			continue
+		}
+		if other == network {
+			resp.Diagnostics.AddAttributeError(path.Root("network"), "Duplicate Route",
+				fmt.Sprintf("Network %s is already routed by route %s via gateway %q. Each destination network can only have one static route.",
+					network, id, route.Gateway.String()))
+		}
+	}
+}
+
 func (r *routeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -2,6 +2,7 @@ package routes_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
@@ -106,6 +107,78 @@ func TestAccRouteResource_IPv6(t *testing.T) {
 	})
 }
 
+func TestAccRouteResource_Host(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteResourceConfig(testRouteGateway, "192.0.2.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_route.test", "network", "192.0.2.10"),
+					resource.TestCheckResourceAttrSet("opnsense_route.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "opnsense_route.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRouteResource_InvalidNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRouteResourceConfig(testRouteGateway, "192.0.2.0/33"),
+				ExpectError: regexp.MustCompile("must be a valid IPv4 or IPv6 address or CIDR"),
+			},
+		},
+	})
+}
+
+func TestAccRouteResource_FamilyMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRouteResourceConfig(testRouteGateway, "2001:db8::/32"),
+				ExpectError: regexp.MustCompile("Address Family Mismatch"),
+			},
+			{
+				Config:      testAccRouteResourceConfig(testRouteGatewayV6, "192.0.2.0/24"),
+				ExpectError: regexp.MustCompile("Address Family Mismatch"),
+			},
+		},
+	})
+}
+
+func TestAccRouteResource_DuplicateNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteResourceConfig(testRouteGateway, "192.0.2.0/24"),
+			},
+			{
+				Config: testAccRouteResourceConfig(testRouteGateway, "192.0.2.0/24") + `
+resource "opnsense_route" "duplicate" {
+  gateway = "WAN_DHCP"
+  network = "192.0.2.0/24"
+}
+`,
+				ExpectError: regexp.MustCompile("Duplicate Route"),
+			},
+		},
+	})
+}
+
 func testAccRouteResourceConfig(gateway, network string) string {
 	return fmt.Sprintf(`
 resource "opnsense_route" "test" {
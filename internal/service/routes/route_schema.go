@@ -1,14 +1,18 @@
 package routes
 
 import (
+	"net/netip"
+
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/routes"
 	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -38,12 +42,15 @@ func routeResourceSchema() schema.Schema {
 				Optional:            true,
 			},
 			"gateway": schema.StringAttribute{
-				MarkdownDescription: "Which gateway this route applies, e.g. `WAN`. Must be an existing gateway.",
+				MarkdownDescription: "Which gateway this route applies, e.g. `WAN`. Must be an existing gateway of the same address family as `network`, such as `opnsense_routing_gateway.example.name`.",
 				Required:            true,
 			},
 			"network": schema.StringAttribute{
-				MarkdownDescription: "Destination network for this static route.",
+				MarkdownDescription: "Destination network for this static route in CIDR notation, e.g. `10.0.0.0/24`, or a single address for a host route. Must be of the same address family as `gateway`, and not be routed by another route.",
 				Required:            true,
+				Validators: []validator.String{
+					validators.IpOrCIDR(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -102,3 +109,16 @@ func convertRouteStructToSchema(d *routes.Route) (*routeResourceModel, error) {
 		Network:     types.StringValue(d.Network),
 	}, nil
 }
+
+// routeNetworkPrefix parses the network of a route. A bare address is a host
+// route, e.g. `10.0.0.1` is the same destination as `10.0.0.1/32`.
+func routeNetworkPrefix(s string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
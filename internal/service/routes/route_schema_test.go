@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteNetworkPrefix(t *testing.T) {
+	for value, expected := range map[string]string{
+		"10.0.0.1":      "10.0.0.1/32",
+		"10.0.0.0/24":   "10.0.0.0/24",
+		"10.0.0.1/24":   "10.0.0.0/24",
+		"2001:db8::1":   "2001:db8::1/128",
+		"2001:db8::/32": "2001:db8::/32",
+	} {
+		prefix, err := routeNetworkPrefix(value)
+		require.NoError(t, err, value)
+		require.Equal(t, expected, prefix.String(), value)
+	}
+
+	_, err := routeNetworkPrefix("10.0.0.0/33")
+	require.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package trafficshaper
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newPipeResource,
+		newQueueResource,
+		newRuleResource,
+	}
+}
+
+func DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newPipeDataSource,
+		newQueueDataSource,
+		newRuleDataSource,
+	}
+}
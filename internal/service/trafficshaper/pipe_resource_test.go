@@ -0,0 +1,61 @@
+package trafficshaper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTrafficshaperPipeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTrafficshaperPipeResourceConfig(100, "Mbit", "wf2q", "Testing pipe"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "bandwidth", "100"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "bandwidth_metric", "Mbit"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "mask", "none"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "scheduler", "wf2q"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "queue", "-1"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "description", "Testing pipe"),
+					resource.TestCheckResourceAttrSet("opnsense_trafficshaper_pipe.test", "number"),
+					resource.TestCheckResourceAttrSet("opnsense_trafficshaper_pipe.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_trafficshaper_pipe.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTrafficshaperPipeResourceConfig(500, "Kbit", "fq_codel", "Updated pipe"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "bandwidth", "500"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "bandwidth_metric", "Kbit"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "scheduler", "fq_codel"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_pipe.test", "description", "Updated pipe"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTrafficshaperPipeResourceConfig(bandwidth int, metric, scheduler, description string) string {
+	return fmt.Sprintf(`
+resource "opnsense_trafficshaper_pipe" "test" {
+  bandwidth        = %[1]d
+  bandwidth_metric = %[2]q
+  scheduler        = %[3]q
+  description      = %[4]q
+}
+`, bandwidth, metric, scheduler, description)
+}
@@ -0,0 +1,258 @@
+package trafficshaper
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/trafficshaper"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pipeResourceModel describes the resource data model.
+type pipeResourceModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Number          types.Int64  `tfsdk:"number"`
+	Bandwidth       types.Int64  `tfsdk:"bandwidth"`
+	BandwidthMetric types.String `tfsdk:"bandwidth_metric"`
+	Queue           types.Int64  `tfsdk:"queue"`
+	Mask            types.String `tfsdk:"mask"`
+	Buckets         types.Int64  `tfsdk:"buckets"`
+	Scheduler       types.String `tfsdk:"scheduler"`
+	CodelEnabled    types.Bool   `tfsdk:"codel_enabled"`
+	CodelEcn        types.Bool   `tfsdk:"codel_ecn"`
+	Delay           types.Int64  `tfsdk:"delay"`
+	Description     types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func pipeResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Pipes limit the total bandwidth available to the traffic passed to them. Traffic is sent to a pipe directly by a shaper rule, or through one or more weighted queues.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this pipe. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "Number of the pipe, assigned by OPNsense.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"bandwidth": schema.Int64Attribute{
+				MarkdownDescription: "Total bandwidth of the pipe, in `bandwidth_metric` units.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"bandwidth_metric": schema.StringAttribute{
+				MarkdownDescription: "Unit of `bandwidth`. One of `bit`, `Kbit`, `Mbit` or `Gbit`. Defaults to `Mbit`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Mbit"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("bit", "Kbit", "Mbit", "Gbit"),
+				},
+			},
+			"queue": schema.Int64Attribute{
+				MarkdownDescription: "Number of dynamic queue slots of the pipe. Defaults to `-1` (system default).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(2, 100),
+					),
+				},
+			},
+			"mask": schema.StringAttribute{
+				MarkdownDescription: "Create a dynamic pipe per source or destination address, so every host receives the full bandwidth of the pipe. One of `none`, `src-ip` or `dst-ip`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "src-ip", "dst-ip"),
+				},
+			},
+			"buckets": schema.Int64Attribute{
+				MarkdownDescription: "Size of the hash table used to store dynamic pipes when `mask` is set. Defaults to `-1` (system default).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"scheduler": schema.StringAttribute{
+				MarkdownDescription: "Scheduler type of the pipe. One of `wf2q` (weighted fair queueing), `fifo`, `rr` (deficit round robin), `qfq` (quick fair queueing), `fq_codel` or `fq_pie`. Defaults to `wf2q`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("wf2q"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("wf2q", "fifo", "rr", "qfq", "fq_codel", "fq_pie"),
+				},
+			},
+			"codel_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable CoDel active queue management on the pipe. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"codel_ecn": schema.BoolAttribute{
+				MarkdownDescription: "Enable Explicit Congestion Notification for CoDel and FQ-CoDel. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"delay": schema.Int64Attribute{
+				MarkdownDescription: "Add a delay in milliseconds to all packets passing through the pipe. Defaults to `-1` (no delay).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 3000),
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the pipe.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the pipe.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func pipeDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Pipes limit the total bandwidth available to the traffic passed to them. Traffic is sent to a pipe directly by a shaper rule, or through one or more weighted queues.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this pipe is enabled.",
+				Computed:            true,
+			},
+			"number": dschema.Int64Attribute{
+				MarkdownDescription: "Number of the pipe.",
+				Computed:            true,
+			},
+			"bandwidth": dschema.Int64Attribute{
+				MarkdownDescription: "Total bandwidth of the pipe, in `bandwidth_metric` units.",
+				Computed:            true,
+			},
+			"bandwidth_metric": dschema.StringAttribute{
+				MarkdownDescription: "Unit of `bandwidth`.",
+				Computed:            true,
+			},
+			"queue": dschema.Int64Attribute{
+				MarkdownDescription: "Number of dynamic queue slots of the pipe. `-1` if the system default is used.",
+				Computed:            true,
+			},
+			"mask": dschema.StringAttribute{
+				MarkdownDescription: "Whether a dynamic pipe is created per source (`src-ip`) or destination (`dst-ip`) address.",
+				Computed:            true,
+			},
+			"buckets": dschema.Int64Attribute{
+				MarkdownDescription: "Size of the hash table used to store dynamic pipes. `-1` if the system default is used.",
+				Computed:            true,
+			},
+			"scheduler": dschema.StringAttribute{
+				MarkdownDescription: "Scheduler type of the pipe.",
+				Computed:            true,
+			},
+			"codel_enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether CoDel active queue management is enabled on the pipe.",
+				Computed:            true,
+			},
+			"codel_ecn": dschema.BoolAttribute{
+				MarkdownDescription: "Whether Explicit Congestion Notification is enabled for CoDel and FQ-CoDel.",
+				Computed:            true,
+			},
+			"delay": dschema.Int64Attribute{
+				MarkdownDescription: "Delay in milliseconds added to all packets passing through the pipe. `-1` if no delay is added.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the pipe.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertPipeSchemaToStruct(d *pipeResourceModel) (*trafficshaper.Pipe, error) {
+	// map Terraform "wf2q" → API ""
+	scheduler := d.Scheduler.ValueString()
+	if scheduler == "wf2q" {
+		scheduler = ""
+	}
+
+	return &trafficshaper.Pipe{
+		Enabled:         tools.BoolToString(d.Enabled.ValueBool()),
+		Bandwidth:       tools.Int64ToString(d.Bandwidth.ValueInt64()),
+		BandwidthMetric: api.SelectedMap(d.BandwidthMetric.ValueString()),
+		Queue:           tools.Int64ToStringNegative(d.Queue.ValueInt64()),
+		Mask:            api.SelectedMap(d.Mask.ValueString()),
+		Buckets:         tools.Int64ToStringNegative(d.Buckets.ValueInt64()),
+		Scheduler:       api.SelectedMap(scheduler),
+		CodelEnabled:    tools.BoolToString(d.CodelEnabled.ValueBool()),
+		CodelEcn:        tools.BoolToString(d.CodelEcn.ValueBool()),
+		Delay:           tools.Int64ToStringNegative(d.Delay.ValueInt64()),
+		Description:     d.Description.ValueString(),
+	}, nil
+}
+
+func convertPipeStructToSchema(d *trafficshaper.Pipe) (*pipeResourceModel, error) {
+	// map API "" → Terraform "wf2q"
+	scheduler := d.Scheduler.String()
+	if scheduler == "" {
+		scheduler = "wf2q"
+	}
+
+	return &pipeResourceModel{
+		Enabled:         types.BoolValue(tools.StringToBool(d.Enabled)),
+		Number:          tools.StringToInt64Null(d.Number),
+		Bandwidth:       tools.StringToInt64Null(d.Bandwidth),
+		BandwidthMetric: types.StringValue(d.BandwidthMetric.String()),
+		Queue:           types.Int64Value(tools.StringToInt64(d.Queue)),
+		Mask:            types.StringValue(d.Mask.String()),
+		Buckets:         types.Int64Value(tools.StringToInt64(d.Buckets)),
+		Scheduler:       types.StringValue(scheduler),
+		CodelEnabled:    types.BoolValue(tools.StringToBool(d.CodelEnabled)),
+		CodelEcn:        types.BoolValue(tools.StringToBool(d.CodelEcn)),
+		Delay:           types.Int64Value(tools.StringToInt64(d.Delay)),
+		Description:     types.StringValue(d.Description),
+	}, nil
+}
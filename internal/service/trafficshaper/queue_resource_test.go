@@ -0,0 +1,63 @@
+package trafficshaper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTrafficshaperQueueResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTrafficshaperQueueResourceConfig(50, "none", "Testing queue"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_queue.test", "enabled", "true"),
+					resource.TestCheckResourceAttrPair("opnsense_trafficshaper_queue.test", "pipe", "opnsense_trafficshaper_pipe.test", "id"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_queue.test", "weight", "50"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_queue.test", "mask", "none"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_queue.test", "description", "Testing queue"),
+					resource.TestCheckResourceAttrSet("opnsense_trafficshaper_queue.test", "number"),
+					resource.TestCheckResourceAttrSet("opnsense_trafficshaper_queue.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_trafficshaper_queue.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTrafficshaperQueueResourceConfig(10, "src-ip", "Updated queue"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_queue.test", "weight", "10"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_queue.test", "mask", "src-ip"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_queue.test", "description", "Updated queue"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTrafficshaperQueueResourceConfig(weight int, mask, description string) string {
+	return fmt.Sprintf(`
+resource "opnsense_trafficshaper_pipe" "test" {
+  bandwidth   = 100
+  description = "Testing queue pipe"
+}
+
+resource "opnsense_trafficshaper_queue" "test" {
+  pipe        = opnsense_trafficshaper_pipe.test.id
+  weight      = %[1]d
+  mask        = %[2]q
+  description = %[3]q
+}
+`, weight, mask, description)
+}
@@ -0,0 +1,193 @@
+package trafficshaper
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/trafficshaper"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// queueResourceModel describes the resource data model.
+type queueResourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Number       types.Int64  `tfsdk:"number"`
+	Pipe         types.String `tfsdk:"pipe"`
+	Weight       types.Int64  `tfsdk:"weight"`
+	Mask         types.String `tfsdk:"mask"`
+	Buckets      types.Int64  `tfsdk:"buckets"`
+	CodelEnabled types.Bool   `tfsdk:"codel_enabled"`
+	CodelEcn     types.Bool   `tfsdk:"codel_ecn"`
+	Description  types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func queueResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Queues share the bandwidth of a pipe between different types of traffic. Each queue receives a share of the pipe's bandwidth proportional to its weight.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this queue. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "Number of the queue, assigned by OPNsense.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"pipe": schema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe this queue is attached to (e.g. `opnsense_trafficshaper_pipe.example.id`).",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IsUUIDv4(),
+				},
+			},
+			"weight": schema.Int64Attribute{
+				MarkdownDescription: "Weight of the queue, between `1` and `100`. Queues attached to the same pipe share its bandwidth in proportion to their weight. Defaults to `100`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(100),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"mask": schema.StringAttribute{
+				MarkdownDescription: "Create a dynamic queue per source or destination address, so every host receives an equal share of the queue. One of `none`, `src-ip` or `dst-ip`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "src-ip", "dst-ip"),
+				},
+			},
+			"buckets": schema.Int64Attribute{
+				MarkdownDescription: "Size of the hash table used to store dynamic queues when `mask` is set. Defaults to `-1` (system default).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"codel_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable CoDel active queue management on the queue. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"codel_ecn": schema.BoolAttribute{
+				MarkdownDescription: "Enable Explicit Congestion Notification for CoDel. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the queue.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the queue.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func queueDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Queues share the bandwidth of a pipe between different types of traffic. Each queue receives a share of the pipe's bandwidth proportional to its weight.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the queue.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this queue is enabled.",
+				Computed:            true,
+			},
+			"number": dschema.Int64Attribute{
+				MarkdownDescription: "Number of the queue.",
+				Computed:            true,
+			},
+			"pipe": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe this queue is attached to.",
+				Computed:            true,
+			},
+			"weight": dschema.Int64Attribute{
+				MarkdownDescription: "Weight of the queue.",
+				Computed:            true,
+			},
+			"mask": dschema.StringAttribute{
+				MarkdownDescription: "Whether a dynamic queue is created per source (`src-ip`) or destination (`dst-ip`) address.",
+				Computed:            true,
+			},
+			"buckets": dschema.Int64Attribute{
+				MarkdownDescription: "Size of the hash table used to store dynamic queues. `-1` if the system default is used.",
+				Computed:            true,
+			},
+			"codel_enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether CoDel active queue management is enabled on the queue.",
+				Computed:            true,
+			},
+			"codel_ecn": dschema.BoolAttribute{
+				MarkdownDescription: "Whether Explicit Congestion Notification is enabled for CoDel.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the queue.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertQueueSchemaToStruct(d *queueResourceModel) (*trafficshaper.Queue, error) {
+	return &trafficshaper.Queue{
+		Enabled:      tools.BoolToString(d.Enabled.ValueBool()),
+		Pipe:         api.SelectedMap(d.Pipe.ValueString()),
+		Weight:       tools.Int64ToString(d.Weight.ValueInt64()),
+		Mask:         api.SelectedMap(d.Mask.ValueString()),
+		Buckets:      tools.Int64ToStringNegative(d.Buckets.ValueInt64()),
+		CodelEnabled: tools.BoolToString(d.CodelEnabled.ValueBool()),
+		CodelEcn:     tools.BoolToString(d.CodelEcn.ValueBool()),
+		Description:  d.Description.ValueString(),
+	}, nil
+}
+
+func convertQueueStructToSchema(d *trafficshaper.Queue) (*queueResourceModel, error) {
+	return &queueResourceModel{
+		Enabled:      types.BoolValue(tools.StringToBool(d.Enabled)),
+		Number:       tools.StringToInt64Null(d.Number),
+		Pipe:         types.StringValue(d.Pipe.String()),
+		Weight:       tools.StringToInt64Null(d.Weight),
+		Mask:         types.StringValue(d.Mask.String()),
+		Buckets:      types.Int64Value(tools.StringToInt64(d.Buckets)),
+		CodelEnabled: types.BoolValue(tools.StringToBool(d.CodelEnabled)),
+		CodelEcn:     types.BoolValue(tools.StringToBool(d.CodelEcn)),
+		Description:  types.StringValue(d.Description),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package trafficshaper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/browningluke/terraform-provider-opnsense/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTrafficshaperRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTrafficshaperRuleResourceConfig("ip", "any", "both", "Testing rule"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "interface", "wan"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "protocol", "ip"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "source", "any"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "direction", "both"),
+					resource.TestCheckResourceAttrPair("opnsense_trafficshaper_rule.test", "target", "opnsense_trafficshaper_queue.test", "id"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "description", "Testing rule"),
+					resource.TestCheckResourceAttrSet("opnsense_trafficshaper_rule.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_trafficshaper_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTrafficshaperRuleResourceConfig("tcp", "192.168.1.0/24", "out", "Updated rule"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "source", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "direction", "out"),
+					resource.TestCheckResourceAttr("opnsense_trafficshaper_rule.test", "description", "Updated rule"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTrafficshaperRuleResourceConfig(protocol, source, direction, description string) string {
+	return fmt.Sprintf(`
+resource "opnsense_trafficshaper_pipe" "test" {
+  bandwidth   = 100
+  description = "Testing rule pipe"
+}
+
+resource "opnsense_trafficshaper_queue" "test" {
+  pipe        = opnsense_trafficshaper_pipe.test.id
+  description = "Testing rule queue"
+}
+
+resource "opnsense_trafficshaper_rule" "test" {
+  interface   = "wan"
+  protocol    = %[1]q
+  source      = %[2]q
+  direction   = %[3]q
+  target      = opnsense_trafficshaper_queue.test.id
+  description = %[4]q
+}
+`, protocol, source, direction, description)
+}
@@ -0,0 +1,260 @@
+package trafficshaper
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/trafficshaper"
+	"github.com/browningluke/terraform-provider-opnsense/internal/tools"
+	"github.com/browningluke/terraform-provider-opnsense/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ruleResourceModel describes the resource data model.
+type ruleResourceModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Sequence        types.Int64  `tfsdk:"sequence"`
+	Interface       types.String `tfsdk:"interface"`
+	Interface2      types.String `tfsdk:"interface2"`
+	Protocol        types.String `tfsdk:"protocol"`
+	Source          types.String `tfsdk:"source"`
+	SourceNot       types.Bool   `tfsdk:"source_not"`
+	SourcePort      types.String `tfsdk:"source_port"`
+	Destination     types.String `tfsdk:"destination"`
+	DestinationNot  types.Bool   `tfsdk:"destination_not"`
+	DestinationPort types.String `tfsdk:"destination_port"`
+	Direction       types.String `tfsdk:"direction"`
+	Target          types.String `tfsdk:"target"`
+	Description     types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func ruleResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Shaper rules select the traffic to shape and send it to a pipe or queue.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this rule. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"sequence": schema.Int64Attribute{
+				MarkdownDescription: "Order in which the rule is evaluated. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface the rule matches traffic on, e.g. `wan`.",
+				Required:            true,
+			},
+			"interface2": schema.StringAttribute{
+				MarkdownDescription: "Only match traffic that also passes this second interface, e.g. `lan`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol to match. One of `ip`, `ip4`, `ip6`, `udp`, `tcp`, `tcp_ack`, `tcp_ack_not`, `icmp`, `ipv6-icmp`, `igmp`, `esp`, `ah` or `gre`. Defaults to `ip`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ip"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ip", "ip4", "ip6", "udp", "tcp", "tcp_ack", "tcp_ack_not", "icmp", "ipv6-icmp", "igmp", "esp", "ah", "gre"),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Source address or network to match, or `any`. Defaults to `any`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"source_not": schema.BoolAttribute{
+				MarkdownDescription: "Invert the sense of the `source` match. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"source_port": schema.StringAttribute{
+				MarkdownDescription: "Source port to match, or `any`. Defaults to `any`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "Destination address or network to match, or `any`. Defaults to `any`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"destination_not": schema.BoolAttribute{
+				MarkdownDescription: "Invert the sense of the `destination` match. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"destination_port": schema.StringAttribute{
+				MarkdownDescription: "Destination port to match, or `any`. Defaults to `any`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "Direction of the traffic to match. One of `in`, `out` or `both`. Defaults to `both`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("both"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("in", "out", "both"),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe or queue matching traffic is sent to (e.g. `opnsense_trafficshaper_queue.example.id`).",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IsUUIDv4(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func ruleDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Shaper rules select the traffic to shape and send it to a pipe or queue.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the rule.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this rule is enabled.",
+				Computed:            true,
+			},
+			"sequence": dschema.Int64Attribute{
+				MarkdownDescription: "Order in which the rule is evaluated.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "Interface the rule matches traffic on.",
+				Computed:            true,
+			},
+			"interface2": dschema.StringAttribute{
+				MarkdownDescription: "Second interface the traffic must also pass.",
+				Computed:            true,
+			},
+			"protocol": dschema.StringAttribute{
+				MarkdownDescription: "Protocol to match.",
+				Computed:            true,
+			},
+			"source": dschema.StringAttribute{
+				MarkdownDescription: "Source address or network to match.",
+				Computed:            true,
+			},
+			"source_not": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the sense of the `source` match is inverted.",
+				Computed:            true,
+			},
+			"source_port": dschema.StringAttribute{
+				MarkdownDescription: "Source port to match.",
+				Computed:            true,
+			},
+			"destination": dschema.StringAttribute{
+				MarkdownDescription: "Destination address or network to match.",
+				Computed:            true,
+			},
+			"destination_not": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the sense of the `destination` match is inverted.",
+				Computed:            true,
+			},
+			"destination_port": dschema.StringAttribute{
+				MarkdownDescription: "Destination port to match.",
+				Computed:            true,
+			},
+			"direction": dschema.StringAttribute{
+				MarkdownDescription: "Direction of the traffic to match.",
+				Computed:            true,
+			},
+			"target": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe or queue matching traffic is sent to.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertRuleSchemaToStruct(d *ruleResourceModel) (*trafficshaper.Rule, error) {
+	// map Terraform "both" → API ""
+	direction := d.Direction.ValueString()
+	if direction == "both" {
+		direction = ""
+	}
+
+	return &trafficshaper.Rule{
+		Enabled:         tools.BoolToString(d.Enabled.ValueBool()),
+		Sequence:        tools.Int64ToString(d.Sequence.ValueInt64()),
+		Interface:       api.SelectedMap(d.Interface.ValueString()),
+		Interface2:      api.SelectedMap(d.Interface2.ValueString()),
+		Protocol:        api.SelectedMap(d.Protocol.ValueString()),
+		Source:          d.Source.ValueString(),
+		SourceNot:       tools.BoolToString(d.SourceNot.ValueBool()),
+		SourcePort:      d.SourcePort.ValueString(),
+		Destination:     d.Destination.ValueString(),
+		DestinationNot:  tools.BoolToString(d.DestinationNot.ValueBool()),
+		DestinationPort: d.DestinationPort.ValueString(),
+		Direction:       api.SelectedMap(direction),
+		Target:          api.SelectedMap(d.Target.ValueString()),
+		Description:     d.Description.ValueString(),
+	}, nil
+}
+
+func convertRuleStructToSchema(d *trafficshaper.Rule) (*ruleResourceModel, error) {
+	// map API "" → Terraform "both"
+	direction := d.Direction.String()
+	if direction == "" {
+		direction = "both"
+	}
+
+	return &ruleResourceModel{
+		Enabled:         types.BoolValue(tools.StringToBool(d.Enabled)),
+		Sequence:        tools.StringToInt64Null(d.Sequence),
+		Interface:       types.StringValue(d.Interface.String()),
+		Interface2:      types.StringValue(d.Interface2.String()),
+		Protocol:        types.StringValue(d.Protocol.String()),
+		Source:          types.StringValue(d.Source),
+		SourceNot:       types.BoolValue(tools.StringToBool(d.SourceNot)),
+		SourcePort:      types.StringValue(d.SourcePort),
+		Destination:     types.StringValue(d.Destination),
+		DestinationNot:  types.BoolValue(tools.StringToBool(d.DestinationNot)),
+		DestinationPort: types.StringValue(d.DestinationPort),
+		Direction:       types.StringValue(direction),
+		Target:          types.StringValue(d.Target.String()),
+		Description:     tools.StringOrNull(d.Description),
+	}, nil
+}
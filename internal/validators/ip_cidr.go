@@ -4,7 +4,7 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"regexp"
+	"net/netip"
 )
 
 type ipOrCIDRValidator struct{}
@@ -22,7 +22,11 @@ func (validator ipOrCIDRValidator) ValidateString(ctx context.Context, request v
 		return
 	}
 
-	if _, err := regexp.Compile(`^(([0-9]{1,3}\.){3}[0-9]{1,3}(\/([0-9]|[1-2][0-9]|3[0-2]))?|([0-9a-fA-F:]+)(\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))?)$`); err != nil {
+	value := request.ConfigValue.ValueString()
+	if _, err := netip.ParseAddr(value); err == nil {
+		return
+	}
+	if _, err := netip.ParsePrefix(value); err != nil {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
@@ -51,7 +55,7 @@ func (validator cidrValidator) ValidateString(ctx context.Context, request valid
 		return
 	}
 
-	if _, err := regexp.Compile(`^(([0-9]{1,3}\.){3}[0-9]{1,3}\/(3[0-2]|[1-2]?[0-9]))$|^(([0-9a-fA-F:]+)\/(12[0-8]|1[0-1][0-9]|[1-9]?[0-9]))$`); err != nil {
+	if _, err := netip.ParsePrefix(request.ConfigValue.ValueString()); err != nil {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
@@ -64,3 +68,71 @@ func (validator cidrValidator) ValidateString(ctx context.Context, request valid
 func CIDR() validator.String {
 	return cidrValidator{}
 }
+
+type ipValidator struct{}
+
+func (validator ipValidator) Description(_ context.Context) string {
+	return "must be a valid IPv4 or IPv6 address (e.g. 192.168.0.1, 2001:db8::1)"
+}
+
+func (validator ipValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator ipValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := netip.ParseAddr(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+func IP() validator.String {
+	return ipValidator{}
+}
+
+type ipFamilyValidator struct {
+	ipv6 bool
+}
+
+func (validator ipFamilyValidator) Description(_ context.Context) string {
+	if validator.ipv6 {
+		return "must be a valid IPv6 address (e.g. 2001:db8::1)"
+	}
+	return "must be a valid IPv4 address (e.g. 192.168.0.1)"
+}
+
+func (validator ipFamilyValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator ipFamilyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	addr, err := netip.ParseAddr(request.ConfigValue.ValueString())
+	if err != nil || addr.Is6() != validator.ipv6 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+func IPv4() validator.String {
+	return ipFamilyValidator{}
+}
+
+func IPv6() validator.String {
+	return ipFamilyValidator{ipv6: true}
+}
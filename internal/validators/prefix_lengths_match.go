@@ -0,0 +1,135 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// prefixLengthsMatchValidator is a resource-level validator that ensures two
+// CIDR prefix attributes share the same address family and prefix length.
+type prefixLengthsMatchValidator struct {
+	firstPath  path.Expression
+	secondPath path.Expression
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v prefixLengthsMatchValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Ensures the prefixes at %q and %q are of the same address family and prefix length",
+		v.firstPath.String(), v.secondPath.String())
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v prefixLengthsMatchValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+func (v prefixLengthsMatchValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	first, diags := v.getPrefix(ctx, req, v.firstPath)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || first == nil {
+		return
+	}
+
+	second, diags := v.getPrefix(ctx, req, v.secondPath)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || second == nil {
+		return
+	}
+
+	if first.Addr().Is4() != second.Addr().Is4() {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The %s value (%s) and the %s value (%s) must be of the same address family.",
+				v.firstPath.String(), first.String(),
+				v.secondPath.String(), second.String(),
+			),
+		)
+		return
+	}
+
+	if first.Bits() != second.Bits() {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The %s prefix length (/%d) must match the %s prefix length (/%d).",
+				v.firstPath.String(), first.Bits(),
+				v.secondPath.String(), second.Bits(),
+			),
+		)
+	}
+}
+
+// getPrefix extracts a CIDR prefix from the given path expression.
+// Returns nil if the value is null, unknown or empty, and an attribute error
+// if the value is not a valid prefix.
+func (v prefixLengthsMatchValidator) getPrefix(ctx context.Context, req resource.ValidateConfigRequest, expression path.Expression) (*netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matchedPaths, pathDiags := req.Config.PathMatches(ctx, expression)
+	diags.Append(pathDiags...)
+	if pathDiags.HasError() {
+		return nil, diags
+	}
+	if len(matchedPaths) == 0 {
+		return nil, diags
+	}
+
+	var matchedPathValue attr.Value
+	getDiags := req.Config.GetAttribute(ctx, matchedPaths[0], &matchedPathValue)
+	diags.Append(getDiags...)
+	if getDiags.HasError() {
+		return nil, diags
+	}
+
+	if matchedPathValue.IsNull() || matchedPathValue.IsUnknown() {
+		return nil, diags
+	}
+
+	var value types.String
+	valueDiags := tfsdk.ValueAs(ctx, matchedPathValue, &value)
+	diags.Append(valueDiags...)
+	if valueDiags.HasError() {
+		return nil, diags
+	}
+
+	if value.ValueString() == "" {
+		return nil, diags
+	}
+
+	prefix, err := netip.ParsePrefix(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			matchedPaths[0],
+			"Invalid Prefix",
+			fmt.Sprintf("%q is not a valid CIDR prefix: %s", value.ValueString(), err),
+		)
+		return nil, diags
+	}
+
+	return &prefix, diags
+}
+
+// PrefixLengthsMatch returns a validator that ensures the CIDR prefixes at
+// firstPath and secondPath are of the same address family and have the same
+// prefix length. Empty values are treated as "not set" and will skip validation.
+//
+// Example usage:
+//
+//	validators.PrefixLengthsMatch(
+//	    path.MatchRoot("internal_prefix"),
+//	    path.MatchRoot("external_prefix"),
+//	)
+func PrefixLengthsMatch(firstPath, secondPath path.Expression) resource.ConfigValidator {
+	return prefixLengthsMatchValidator{
+		firstPath:  firstPath,
+		secondPath: secondPath,
+	}
+}